@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+)
+
+// promptChooser asks the player which ability to use, falling back to a basic
+// attack on an empty or unknown answer.
+type promptChooser struct {
+	scanner *bufio.Scanner
+}
+
+func (chooser promptChooser) Choose(actor combat.Actor, allies []combat.Actor, opponents []combat.Actor) combat.Choice {
+	choice := combat.BasicAttack{}.Choose(actor, allies, opponents)
+
+	ready := make([]ability.Ability, 0)
+	for _, known := range actor.Abilities() {
+		if actor.Ready(known.ID) {
+			ready = append(ready, known)
+		}
+	}
+
+	if len(ready) == 0 {
+		return choice
+	}
+
+	fmt.Println("Your turn! [enter] attack")
+	for i, known := range ready {
+		fmt.Printf("  %d) %s (%d)\n", i+1, known.Name, known.Cost)
+	}
+
+	if !chooser.scanner.Scan() {
+		return choice
+	}
+
+	index, err := strconv.Atoi(strings.TrimSpace(chooser.scanner.Text()))
+	if err != nil || index < 1 || index > len(ready) {
+		return choice
+	}
+
+	choice.Ability = ready[index-1].ID
+	if ready[index-1].Target == ability.TargetAll {
+		choice.Targets = opponents
+	}
+
+	return choice
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/leaderboard"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+)
+
+func runCommand(name string, args []string) error {
+	switch name {
+	case "play":
+		return playCommand(args)
+	case "leaderboard":
+		return leaderboardCommand(args)
+	case "validate":
+		return validateCommand(args)
+	default:
+		return errors.New("unknown command")
+	}
+}
+
+// playCommand runs an adventure, replaying the one with the given seed when
+// there is one.
+func playCommand(args []string) error {
+	flags := flag.NewFlagSet("play", flag.ContinueOnError)
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed the adventure's rolls are drawn from")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	play(*seed)
+
+	return nil
+}
+
+func leaderboardCommand(args []string) error {
+	flags := flag.NewFlagSet("leaderboard", flag.ContinueOnError)
+	format := flags.String("format", string(leaderboard.FormatTable), "output format: table, csv or json")
+	output := flags.String("output", "", "file to export to, defaults to standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	statistics, err := observer.LoadStatistics(statisticsPath)
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		writer = file
+	}
+
+	return leaderboard.Export(writer, leaderboard.Rank(statistics), leaderboard.Format(*format))
+}
+
+// validateCommand checks content packs against the schema and against one
+// another, layered over the packs the game ships with, and prints every
+// problem found. It checks the packs directory unless given pack files or
+// directories.
+func validateCommand(args []string) error {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	format := flags.String("format", "text", "diagnostics format: text or json")
+	schema := flags.Bool("schema", false, "print the JSON Schema packs are checked against")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *schema {
+		_, err := os.Stdout.Write(content.Schema)
+		return err
+	}
+
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	paths := flags.Args()
+	if _, err := os.Stat(packsDir); len(paths) == 0 && err == nil {
+		paths = []string{packsDir}
+	}
+
+	packs, err := embeddedPacks()
+	custom, loadErr := loadPacks(paths)
+	err = errors.Join(err, loadErr)
+	if err == nil {
+		library := content.Merge(append(packs, custom...)...)
+		err = errors.Join(library.Validate(), library.Unplaced())
+	}
+
+	diagnostics := content.Diagnostics(err)
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if encodeErr := encoder.Encode(diagnostics); encodeErr != nil {
+			return encodeErr
+		}
+	} else {
+		for _, diagnostic := range diagnostics {
+			fmt.Println(diagnostic)
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%d problems found", len(diagnostics))
+	}
+
+	if *format == "text" {
+		fmt.Printf("%d packs are valid\n", len(packs)+len(custom))
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is a problem with a definition in a content file, pointing at the
+// line the definition starts on.
+type Error struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+func (err Error) Error() string {
+	if err.Line == 0 {
+		return fmt.Sprintf("%s: %s", err.File, err.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s", err.File, err.Line, err.Message)
+}
+
+// Diagnostics lists every problem joined into the error, in order. Problems
+// that are not about a content file are listed with their message alone.
+func Diagnostics(err error) []Error {
+	diagnostics := []Error{}
+	for _, problem := range flatten(err) {
+		var found Error
+		if !errors.As(problem, &found) {
+			found = Error{Message: problem.Error()}
+		}
+
+		diagnostics = append(diagnostics, found)
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,28 @@
+package content_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Run("lists every joined problem in order", func(t *testing.T) {
+		err := errors.Join(
+			content.Error{File: "crypt.yaml", Line: 3, Message: "unknown item"},
+			errors.Join(content.Error{File: "crypt.yaml", Message: "pack is empty"}, errors.New("disk on fire")),
+		)
+
+		require.Equal(t, []content.Error{
+			{File: "crypt.yaml", Line: 3, Message: "unknown item"},
+			{File: "crypt.yaml", Message: "pack is empty"},
+			{Message: "disk on fire"},
+		}, content.Diagnostics(err))
+	})
+
+	t.Run("lists nothing without problems", func(t *testing.T) {
+		require.Empty(t, content.Diagnostics(nil))
+	})
+}
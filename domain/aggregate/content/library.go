@@ -0,0 +1,345 @@
+package content
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/craft"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/loot"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+)
+
+// Library holds every definition from a set of packs, keyed by name.
+type Library struct {
+	Items    map[string]Item
+	Affixes  map[string]Affix
+	Enemies  map[enemy.Kind]Enemy
+	Puzzles  map[string]Puzzle
+	Rooms    map[string]Room
+	Dungeons map[string]Dungeon
+	Recipes  map[string]Recipe
+
+	// duplicates are the definitions Merge dropped for sharing a name with
+	// another in the same pack, which Validate reports.
+	duplicates []error
+}
+
+// Merge layers the packs from the lowest priority to the highest, so the
+// definitions of higher priority packs replace those of the same name. Packs
+// of the same priority are layered in the order given.
+func Merge(packs ...Pack) *Library {
+	library := &Library{
+		Items:    map[string]Item{},
+		Affixes:  map[string]Affix{},
+		Enemies:  map[enemy.Kind]Enemy{},
+		Puzzles:  map[string]Puzzle{},
+		Rooms:    map[string]Room{},
+		Dungeons: map[string]Dungeon{},
+		Recipes:  map[string]Recipe{},
+	}
+
+	layered := slices.Clone(packs)
+	slices.SortStableFunc(layered, func(a, b Pack) int { return cmp.Compare(a.Priority, b.Priority) })
+
+	for _, pack := range layered {
+		library.duplicates = slices.Concat(library.duplicates,
+			duplicates("item", pack.Items, func(definition Item) (string, Origin) { return definition.Name, definition.Origin }),
+			duplicates("affix", pack.Affixes, func(definition Affix) (string, Origin) { return definition.Name, definition.Origin }),
+			duplicates("enemy", pack.Enemies, func(definition Enemy) (string, Origin) { return string(definition.Kind), definition.Origin }),
+			duplicates("puzzle", pack.Puzzles, func(definition Puzzle) (string, Origin) { return definition.Name, definition.Origin }),
+			duplicates("room", pack.Rooms, func(definition Room) (string, Origin) { return definition.Name, definition.Origin }),
+			duplicates("dungeon", pack.Dungeons, func(definition Dungeon) (string, Origin) { return definition.Name, definition.Origin }),
+			duplicates("recipe", pack.Recipes, func(definition Recipe) (string, Origin) { return definition.Name, definition.Origin }),
+		)
+
+		for _, definition := range pack.Items {
+			library.Items[definition.Name] = definition
+		}
+		for _, definition := range pack.Affixes {
+			library.Affixes[definition.Name] = definition
+		}
+		for _, definition := range pack.Enemies {
+			library.Enemies[definition.Kind] = definition
+		}
+		for _, definition := range pack.Puzzles {
+			library.Puzzles[definition.Name] = definition
+		}
+		for _, definition := range pack.Rooms {
+			library.Rooms[definition.Name] = definition
+		}
+		for _, definition := range pack.Dungeons {
+			library.Dungeons[definition.Name] = definition
+		}
+		for _, definition := range pack.Recipes {
+			library.Recipes[definition.Name] = definition
+		}
+	}
+
+	return library
+}
+
+// duplicates reports every definition sharing its name with an earlier one
+// of the same pack, which would silently replace it.
+func duplicates[T any](noun string, definitions []T, key func(T) (string, Origin)) []error {
+	var problems []error
+	seen := map[string]Origin{}
+	for _, definition := range definitions {
+		name, origin := key(definition)
+		if first, ok := seen[name]; ok {
+			problems = append(problems, origin.errorf("%s %q: already defined on line %d", noun, name, first.Line))
+			continue
+		}
+
+		seen[name] = origin
+	}
+
+	return problems
+}
+
+// Register makes the library's items prototypes, lets generated items roll
+// its affixes and defines its enemy kinds and what they drop, replacing any
+// built-in kinds of the same name.
+func (library *Library) Register() error {
+	for _, name := range slices.Sorted(maps.Keys(library.Items)) {
+		definition := library.Items[name]
+		if err := item.Register(definition.Item); err != nil {
+			return definition.errorf("item %q: %v", name, err)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(library.Affixes)) {
+		definition := library.Affixes[name]
+		if err := item.RegisterAffix(definition.Affix); err != nil {
+			return definition.errorf("affix %q: %v", name, err)
+		}
+	}
+
+	for _, kind := range slices.Sorted(maps.Keys(library.Enemies)) {
+		definition := library.Enemies[kind]
+
+		template := enemy.Template{
+			Life:        definition.Life,
+			Armour:      definition.Armour,
+			MinDamage:   definition.Damage.Min,
+			MaxDamage:   definition.Damage.Max,
+			Resistances: definition.Resistances,
+			Immunities:  definition.Immunities,
+			Experience:  definition.Experience,
+		}
+
+		for _, id := range definition.Abilities {
+			known, err := ability.Lookup(id)
+			if err != nil {
+				return definition.errorf("enemy %q: %v", kind, err)
+			}
+
+			template.Abilities = append(template.Abilities, known)
+		}
+
+		if err := enemy.Register(kind, template); err != nil {
+			return definition.errorf("enemy %q: %v", kind, err)
+		}
+
+		if definition.Loot != nil {
+			table, err := library.table(*definition.Loot)
+			if err != nil {
+				return definition.errorf("enemy %q: %v", kind, err)
+			}
+
+			enemy.RegisterLoot(kind, table)
+		}
+	}
+
+	return nil
+}
+
+// Build fills the state with the rooms and doors of the named dungeon.
+func (library *Library) Build(name string, state *game.State, random dice.Random) error {
+	dungeon, ok := library.Dungeons[name]
+	if !ok {
+		return fmt.Errorf("unknown dungeon %q", name)
+	}
+
+	rooms := make([]room.Room, 0, len(dungeon.Rooms))
+	for _, template := range dungeon.Rooms {
+		built, err := library.room(template, random)
+		if err != nil {
+			return dungeon.errorf("dungeon %q: %v", name, err)
+		}
+
+		rooms = append(rooms, built)
+	}
+
+	doors := map[int]game.Door{}
+	for _, door := range dungeon.Doors {
+		doors[door.Into] = game.Door{Seal: door.Seal, Key: door.Key, Room: door.Room}
+	}
+
+	state.Rooms, state.Doors = rooms, doors
+
+	return nil
+}
+
+// room builds a fresh room from the named template.
+func (library *Library) room(name string, random dice.Random) (room.Room, error) {
+	template, ok := library.Rooms[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown room %q", name)
+	}
+
+	items, err := library.items(template.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	input := room.FactoryInput{
+		Kind:      template.Kind,
+		Items:     items,
+		Scene:     template.Scene,
+		Sanctuary: template.Sanctuary,
+	}
+
+	for _, kind := range template.Enemies {
+		input.Enemies = append(input.Enemies, enemy.New(kind))
+	}
+
+	if template.Boss != "" {
+		input.Boss = enemy.NewBoss(template.Boss)
+	}
+
+	if template.Puzzle != "" {
+		posed, ok := library.Puzzles[template.Puzzle]
+		if !ok {
+			return nil, fmt.Errorf("unknown puzzle %q", template.Puzzle)
+		}
+
+		input.Puzzle = &posed.Definition
+	} else if template.Kind == room.KindPuzzle && len(library.Puzzles) > 0 {
+		names := slices.Sorted(maps.Keys(library.Puzzles))
+		posed := library.Puzzles[names[random.Intn(len(names))]]
+		input.Puzzle = &posed.Definition
+	}
+
+	for _, name := range template.Hazards {
+		hazard, ok := trap.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown hazard %q", name)
+		}
+
+		input.Hazards = append(input.Hazards, hazard)
+	}
+
+	if template.Merchant != nil {
+		input.Merchant, err = library.merchant(*template.Merchant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	built := room.Factory(input)
+	if built == nil {
+		return nil, fmt.Errorf("room %q is missing what a %s room needs", name, template.Kind)
+	}
+
+	return room.Decorate(built, template.Modifiers...), nil
+}
+
+// Book collects the library's recipes, in name order, into a recipe book none
+// of them has been discovered in yet.
+func (library *Library) Book() (*craft.Book, error) {
+	recipes := make([]craft.Recipe, 0, len(library.Recipes))
+	for _, name := range slices.Sorted(maps.Keys(library.Recipes)) {
+		recipe, err := library.recipe(library.Recipes[name])
+		if err != nil {
+			return nil, library.Recipes[name].errorf("recipe %q: %v", name, err)
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	return craft.NewBook(recipes...), nil
+}
+
+func (library *Library) recipe(definition Recipe) (craft.Recipe, error) {
+	result, ok := library.Items[definition.Result]
+	if !ok {
+		return craft.Recipe{}, fmt.Errorf("unknown item %q", definition.Result)
+	}
+
+	if len(definition.Ingredients) == 0 {
+		return craft.Recipe{}, errors.New("needs ingredients")
+	}
+
+	recipe := craft.Recipe{Name: definition.Name, Result: result.Item}
+	for _, ingredient := range definition.Ingredients {
+		if _, ok = library.Items[ingredient.Item]; !ok {
+			return craft.Recipe{}, fmt.Errorf("unknown item %q", ingredient.Item)
+		}
+
+		recipe.Ingredients = append(recipe.Ingredients, craft.Ingredient{Item: ingredient.Item, Count: max(ingredient.Count, 1)})
+	}
+
+	return recipe, nil
+}
+
+func (library *Library) items(names []string) ([]item.Item, error) {
+	items := make([]item.Item, 0, len(names))
+	for _, name := range names {
+		definition, ok := library.Items[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown item %q", name)
+		}
+
+		items = append(items, definition.Item.Clone())
+	}
+
+	return items, nil
+}
+
+func (library *Library) table(definition Loot) (loot.Table, error) {
+	guaranteed, err := library.items(definition.Guaranteed)
+	if err != nil {
+		return loot.Table{}, err
+	}
+
+	table := loot.Table{Guaranteed: guaranteed, Nothing: definition.Nothing, Rolls: definition.Rolls, Gold: definition.Gold}
+	for _, entry := range definition.Entries {
+		found, ok := library.Items[entry.Item]
+		if !ok {
+			return loot.Table{}, fmt.Errorf("unknown item %q", entry.Item)
+		}
+
+		table.Entries = append(table.Entries, loot.Entry{Item: found.Item, Weight: entry.Weight})
+	}
+
+	return table, nil
+}
+
+func (library *Library) merchant(definition Merchant) (*shop.Merchant, error) {
+	merchant := &shop.Merchant{
+		Name:         definition.Name,
+		Modifiers:    definition.Modifiers,
+		RestockEvery: definition.RestockEvery,
+	}
+
+	for _, listing := range definition.Listings {
+		found, ok := library.Items[listing.Item]
+		if !ok {
+			return nil, fmt.Errorf("unknown item %q", listing.Item)
+		}
+
+		merchant.Listings = append(merchant.Listings, &shop.Listing{Item: found.Item, Stock: listing.Stock, Capacity: listing.Stock})
+	}
+
+	return merchant, nil
+}
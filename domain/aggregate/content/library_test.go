@@ -0,0 +1,300 @@
+package content_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func load(t *testing.T, file, source string) content.Pack {
+	t.Helper()
+
+	pack, err := content.Load(file, strings.NewReader(source))
+	require.NoError(t, err)
+
+	return pack
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when a higher priority pack overrides a definition", func(t *testing.T) {
+			mod := load(t, "mod.yaml", "name: mod\npriority: 5\nitems:\n  - {name: Bone club, type: Weapon, rarity: epic}\n")
+			crypt := load(t, "crypt.yaml", yamlPack)
+
+			library := content.Merge(mod, crypt)
+
+			require.Equal(t, "epic", string(library.Items["Bone club"].Rarity))
+			require.Equal(t, "mod.yaml", library.Items["Bone club"].File)
+			require.Contains(t, library.Items, "Grave dust")
+		})
+
+		t.Run("when packs of the same priority are layered in order", func(t *testing.T) {
+			first := load(t, "first.yaml", "name: first\nitems:\n  - {name: Sword, type: Weapon}\n")
+			second := load(t, "second.yaml", "name: second\nitems:\n  - {name: Sword, type: Weapon, rarity: rare}\n")
+
+			library := content.Merge(first, second)
+
+			require.Equal(t, "second.yaml", library.Items["Sword"].File)
+		})
+	})
+}
+
+func TestLibrary_Build(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when every room of the dungeon can be built", func(t *testing.T) {
+			library := content.Merge(load(t, "crypt.yaml", yamlPack))
+			state := &game.State{}
+
+			err := library.Build("Crypt", state, dicetest.Rolls())
+
+			require.NoError(t, err)
+			require.Len(t, state.Rooms, 1)
+			require.Equal(t, enemy.Kind("Skeleton"), state.Rooms[0].Enemies()[0].Type)
+			require.Equal(t, "Grave dust", state.Rooms[0].Items()[0].Name)
+			require.NotNil(t, state.Rooms[0].Items()[0].Instance)
+		})
+
+		t.Run("when a room carries modifiers", func(t *testing.T) {
+			library := content.Merge(load(t, "crypt.yaml", yamlPack+"  - name: Dark crypt\n    rooms: [Gloom]\n"),
+				load(t, "gloom.yaml", "name: gloom\nrooms:\n  - {name: Gloom, kind: Treasure, modifiers: [dark]}\n"))
+			state := &game.State{}
+
+			err := library.Build("Dark crypt", state, dicetest.Rolls())
+
+			require.NoError(t, err)
+			require.True(t, state.Rooms[0].Dark())
+			require.False(t, room.Base(state.Rooms[0]).Dark())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the dungeon is unknown", func(t *testing.T) {
+			library := content.Merge()
+
+			err := library.Build("Crypt", &game.State{}, dicetest.Rolls())
+
+			require.EqualError(t, err, `unknown dungeon "Crypt"`)
+		})
+
+		t.Run("when a room lacks what its kind needs", func(t *testing.T) {
+			library := content.Merge(load(t, "crypt.yaml", "name: crypt\nrooms:\n  - {name: Lair, kind: Boss}\ndungeons:\n  - {name: Crypt, rooms: [Lair]}\n"))
+
+			err := library.Build("Crypt", &game.State{}, dicetest.Rolls())
+
+			require.EqualError(t, err, `crypt.yaml:5: dungeon "Crypt": room "Lair" is missing what a Boss room needs`)
+		})
+	})
+}
+
+func TestLibrary_Register(t *testing.T) {
+	t.Cleanup(enemy.Snapshot())
+	t.Cleanup(item.Snapshot())
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when registering an enemy kind", func(t *testing.T) {
+			library := content.Merge(load(t, "ghoul.yaml", `name: ghoul
+items:
+  - {name: Grave dust, type: Potion}
+enemies:
+  - kind: Ghoul
+    life: 40
+    damage: {min: 2, max: 9}
+    abilities: [power_strike]
+    experience: 25
+    loot:
+      guaranteed: [Grave dust]
+`))
+
+			err := library.Register()
+
+			require.NoError(t, err)
+			ghoul := enemy.New("Ghoul")
+			require.Equal(t, 40, ghoul.Life.Max)
+			require.Len(t, ghoul.Abilities(), 1)
+			require.Equal(t, 25, enemy.Kind("Ghoul").Experience())
+			dust, err := item.Named("grave dust")
+			require.NoError(t, err)
+			require.Equal(t, item.ID("grave-dust"), dust.ID)
+		})
+
+		t.Run("when registering affixes for generated items to roll", func(t *testing.T) {
+			library := content.Merge(load(t, "runes.yaml", `name: runes
+affixes:
+  - {name: Runed, position: prefix, types: [Material], value: 5}
+  - {name: of Embers, position: suffix, types: [Material], power: 1}
+`))
+
+			err := library.Register()
+
+			require.NoError(t, err)
+			require.Equal(t, "runes.yaml", library.Affixes["Runed"].File)
+			stone := item.Item{Name: "Stone", Type: item.Material, Rarity: item.Rare}.Enchant(dicetest.Rolls())
+			require.Equal(t, "Runed Stone of Embers", stone.Title())
+		})
+	})
+}
+
+func TestLibrary_Validate(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the packs shipped with the game are valid", func(t *testing.T) {
+			packs, err := content.LoadFS(os.DirFS("../../../content"))
+			require.NoError(t, err)
+
+			require.NoError(t, content.Merge(packs...).Validate())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when definitions refer to what does not exist, pointing at each", func(t *testing.T) {
+			library := content.Merge(load(t, "broken.yaml", `name: broken
+enemies:
+  - {kind: Ghast, life: 10, damage: {min: 4, max: 2}, abilities: [howl]}
+rooms:
+  - {name: Vault, kind: Treasure, items: [Crown]}
+  - {name: Pit, kind: Trap, enemies: [Hydra], hazards: [Quicksand]}
+dungeons:
+  - {name: Nowhere, rooms: [Vault, Pit, Attic]}
+`))
+
+			err := library.Validate()
+
+			require.EqualError(t, err, strings.Join([]string{
+				`broken.yaml:3: enemy "Ghast": damage range is invalid`,
+				`broken.yaml:3: enemy "Ghast": unknown ability "howl"`,
+				`broken.yaml:6: room "Pit": unknown enemy "Hydra"`,
+				`broken.yaml:6: room "Pit": unknown hazard "Quicksand"`,
+				`broken.yaml:5: room "Vault": unknown item "Crown"`,
+				`broken.yaml:8: dungeon "Nowhere": unknown room "Attic"`,
+			}, "\n"))
+		})
+
+		t.Run("when two items share an id", func(t *testing.T) {
+			library := content.Merge(load(t, "twins.yaml", `name: twins
+items:
+  - {id: blade, name: Sword, type: Weapon}
+  - {id: blade, name: Dagger, type: Weapon}
+`))
+
+			err := library.Validate()
+
+			require.EqualError(t, err, `twins.yaml:3: item "Sword": id "blade" is already used by "Dagger"`)
+		})
+
+		t.Run("when a dungeon cannot be completed", func(t *testing.T) {
+			library := content.Merge(load(t, "locked.yaml", `name: locked
+items:
+  - {name: Iron key, type: Key}
+rooms:
+  - {name: Hall, kind: Treasure}
+  - {name: Vault, kind: Treasure, items: [Iron key]}
+dungeons:
+  - name: Locked
+    rooms: [Hall, Vault]
+    doors:
+      - {into: 1, seal: key, key: Iron key}
+`))
+
+			err := library.Validate()
+
+			require.EqualError(t, err, `locked.yaml:8: dungeon "Locked": door 1: Iron key is locked behind its own door`+"\n"+
+				`locked.yaml:8: dungeon "Locked": room 1 (Vault) is unreachable`)
+		})
+
+		t.Run("when a recipe names items that do not exist", func(t *testing.T) {
+			library := content.Merge(load(t, "forge.yaml", `name: forge
+items:
+  - {name: Sword, type: Weapon}
+recipes:
+  - name: Frost sword
+    ingredients: [{item: Sword}, {item: Ice gem}]
+    result: Frost sword
+  - {name: Nothing, ingredients: [], result: Sword}
+`))
+
+			err := library.Validate()
+
+			require.EqualError(t, err, strings.Join([]string{
+				`forge.yaml:5: recipe "Frost sword": unknown ingredient "Ice gem"`,
+				`forge.yaml:5: recipe "Frost sword": unknown result "Frost sword"`,
+				`forge.yaml:8: recipe "Nothing": needs ingredients`,
+			}, "\n"))
+		})
+
+		t.Run("when a pack defines the same name twice", func(t *testing.T) {
+			library := content.Merge(load(t, "echo.yaml", `name: echo
+items:
+  - {name: Sword, type: Weapon}
+  - {name: Sword, type: Armour}
+rooms:
+  - {name: Hall, kind: Treasure}
+  - {name: Hall, kind: Treasure}
+`))
+
+			err := library.Validate()
+
+			require.EqualError(t, err, `echo.yaml:4: item "Sword": already defined on line 3`+"\n"+
+				`echo.yaml:7: room "Hall": already defined on line 6`)
+		})
+	})
+}
+
+func TestLibrary_Book(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when recipes name the items they use up and make", func(t *testing.T) {
+			library := content.Merge(load(t, "alchemy.yaml", `name: alchemy
+items:
+  - {name: Potion, type: Potion}
+  - {name: Greater potion, type: Potion, rarity: uncommon}
+recipes:
+  - name: Greater potion
+    ingredients: [{item: Potion, count: 2}]
+    result: Greater potion
+`))
+
+			book, err := library.Book()
+
+			require.NoError(t, err)
+			discovered := book.Discover([]item.Item{{Name: "Potion", Type: item.Potion}})
+			require.Len(t, discovered, 1)
+			require.Equal(t, 2, discovered[0].Ingredients[0].Count)
+			require.Equal(t, "Greater potion", discovered[0].Result.Name)
+			require.Equal(t, item.ID("greater-potion"), discovered[0].Result.ID)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when a recipe makes an unknown item", func(t *testing.T) {
+			library := content.Merge(load(t, "alchemy.yaml", "name: alchemy\nrecipes:\n  - {name: Elixir, ingredients: [{item: Potion}], result: Elixir}\n"))
+
+			_, err := library.Book()
+
+			require.EqualError(t, err, `alchemy.yaml:3: recipe "Elixir": unknown item "Elixir"`)
+		})
+	})
+}
+
+func TestLibrary_Unplaced(t *testing.T) {
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when no dungeon leads to a room", func(t *testing.T) {
+			library := content.Merge(load(t, "spare.yaml", `name: spare
+rooms:
+  - {name: Hall, kind: Treasure}
+  - {name: Attic, kind: Treasure}
+dungeons:
+  - {name: Manor, rooms: [Hall]}
+`))
+
+			err := library.Unplaced()
+
+			require.EqualError(t, err, `spare.yaml:4: room "Attic": unreachable, no dungeon leads to it`)
+		})
+	})
+}
@@ -0,0 +1,317 @@
+package content
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"gopkg.in/yaml.v3"
+)
+
+// Pack is a content file describing items, the affixes generated items roll,
+// enemy kinds, puzzles, room templates, hand-authored dungeons and crafting
+// recipes. Packs with a higher priority override the definitions of the same
+// name in packs with a lower one.
+type Pack struct {
+	Name     string    `yaml:"name"`
+	Priority int       `yaml:"priority"`
+	Items    []Item    `yaml:"items"`
+	Affixes  []Affix   `yaml:"affixes"`
+	Enemies  []Enemy   `yaml:"enemies"`
+	Puzzles  []Puzzle  `yaml:"puzzles"`
+	Rooms    []Room    `yaml:"rooms"`
+	Dungeons []Dungeon `yaml:"dungeons"`
+	Recipes  []Recipe  `yaml:"recipes"`
+}
+
+// Origin is where in a content file a definition was found.
+type Origin struct {
+	File string `yaml:"-"`
+	Line int    `yaml:"-"`
+}
+
+func (origin Origin) errorf(format string, args ...any) Error {
+	return Error{File: origin.File, Line: origin.Line, Message: fmt.Sprintf(format, args...)}
+}
+
+// Item is an item definition, looked up by its name. Its ID is derived from
+// the name unless the definition pins one.
+type Item struct {
+	item.Item `yaml:",inline"`
+	Origin    `yaml:"-"`
+}
+
+// Affix is a prefix or suffix generated items can roll, looked up by its name.
+type Affix struct {
+	item.Affix `yaml:",inline"`
+	Origin     `yaml:"-"`
+}
+
+// Enemy is an enemy kind definition along with what it drops.
+type Enemy struct {
+	Kind        enemy.Kind          `yaml:"kind"`
+	Life        int                 `yaml:"life"`
+	Armour      int                 `yaml:"armour"`
+	Damage      Range               `yaml:"damage"`
+	Abilities   []ability.ID        `yaml:"abilities"`
+	Resistances element.Resistances `yaml:"resistances"`
+	Immunities  []effect.Kind       `yaml:"immunities"`
+	Experience  int                 `yaml:"experience"`
+	Loot        *Loot               `yaml:"loot"`
+	Origin      `yaml:"-"`
+}
+
+// Range is a span of values from Min up to Max.
+type Range struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// Loot is a loot table naming the items it drops.
+type Loot struct {
+	Guaranteed []string    `yaml:"guaranteed"`
+	Entries    []LootEntry `yaml:"entries"`
+	Nothing    int         `yaml:"nothing"`
+	Rolls      int         `yaml:"rolls"`
+	Gold       int         `yaml:"gold"`
+}
+
+// LootEntry is an item that can drop, by name, with its weight.
+type LootEntry struct {
+	Item   string `yaml:"item"`
+	Weight int    `yaml:"weight"`
+}
+
+// Puzzle is a puzzle definition, looked up by its name.
+type Puzzle struct {
+	puzzle.Definition `yaml:",inline"`
+	Origin            `yaml:"-"`
+}
+
+// Room is a room template. Items, enemies, the boss, the puzzle and the
+// hazards are named after their definitions; a puzzle room without a puzzle
+// poses one picked at random.
+type Room struct {
+	Name      string          `yaml:"name"`
+	Kind      room.Kind       `yaml:"kind"`
+	Scene     scene.Scene     `yaml:"scene"`
+	Items     []string        `yaml:"items"`
+	Enemies   []enemy.Kind    `yaml:"enemies"`
+	Boss      enemy.Kind      `yaml:"boss"`
+	Puzzle    string          `yaml:"puzzle"`
+	Hazards   []string        `yaml:"hazards"`
+	Merchant  *Merchant       `yaml:"merchant"`
+	Sanctuary *rest.Sanctuary `yaml:"sanctuary"`
+	Modifiers []room.Modifier `yaml:"modifiers"`
+	Origin    `yaml:"-"`
+}
+
+// Merchant is a merchant whose listings name the items they sell.
+type Merchant struct {
+	Name         string          `yaml:"name"`
+	Listings     []Listing       `yaml:"listings"`
+	Modifiers    []shop.Modifier `yaml:"modifiers"`
+	RestockEvery int             `yaml:"restock_every"`
+}
+
+// Listing is an item for sale, by name, and how many the merchant stocks.
+type Listing struct {
+	Item  string `yaml:"item"`
+	Stock int    `yaml:"stock"`
+}
+
+// Dungeon is a hand-authored dungeon: the room templates to walk through in
+// order and the doors between them.
+type Dungeon struct {
+	Name   string   `yaml:"name"`
+	Rooms  []string `yaml:"rooms"`
+	Doors  []Door   `yaml:"doors"`
+	Origin `yaml:"-"`
+}
+
+// Door seals the way into the room at index Into of its dungeon. Room is the
+// index of the room whose puzzle or boss opens it.
+type Door struct {
+	Into int       `yaml:"into"`
+	Seal game.Seal `yaml:"seal"`
+	Key  string    `yaml:"key"`
+	Room int       `yaml:"room"`
+}
+
+// Recipe is a crafting recipe whose ingredients and result name the items
+// they are.
+type Recipe struct {
+	Name        string       `yaml:"name"`
+	Ingredients []Ingredient `yaml:"ingredients"`
+	Result      string       `yaml:"result"`
+	Origin      `yaml:"-"`
+}
+
+// Ingredient is an item a recipe uses up, by name, and how many of it; one
+// when left out.
+type Ingredient struct {
+	Item  string `yaml:"item"`
+	Count int    `yaml:"count"`
+}
+
+var unsupportedFormat = errors.New("unsupported content format, expected .json, .yaml or .yml")
+
+// Load reads a pack from a JSON or YAML file, telling them apart by the
+// file's extension. Errors point at the line they were found on.
+func Load(file string, reader io.Reader) (Pack, error) {
+	pack := Pack{}
+	if !Supported(file) {
+		return pack, Error{File: file, Message: unsupportedFormat.Error()}
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return pack, Error{File: file, Message: err.Error()}
+	}
+
+	// JSON is a subset of YAML, so both formats go through the YAML decoder
+	// and get the same line-aware errors.
+	root := yaml.Node{}
+	if err = yaml.Unmarshal(content, &root); err != nil {
+		return pack, located(file, err)
+	}
+
+	if err = conform(file, &root); err != nil {
+		return pack, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&pack); err != nil && !errors.Is(err, io.EOF) {
+		return pack, located(file, err)
+	}
+
+	for index := range pack.Items {
+		if pack.Items[index].ID == "" {
+			pack.Items[index].ID = item.IDFor(pack.Items[index].Name)
+		}
+	}
+
+	lines := sections(&root)
+	locate(pack.Items, file, lines["items"], func(definition *Item) *Origin { return &definition.Origin })
+	locate(pack.Affixes, file, lines["affixes"], func(definition *Affix) *Origin { return &definition.Origin })
+	locate(pack.Enemies, file, lines["enemies"], func(definition *Enemy) *Origin { return &definition.Origin })
+	locate(pack.Puzzles, file, lines["puzzles"], func(definition *Puzzle) *Origin { return &definition.Origin })
+	locate(pack.Rooms, file, lines["rooms"], func(definition *Room) *Origin { return &definition.Origin })
+	locate(pack.Dungeons, file, lines["dungeons"], func(definition *Dungeon) *Origin { return &definition.Origin })
+	locate(pack.Recipes, file, lines["recipes"], func(definition *Recipe) *Origin { return &definition.Origin })
+
+	return pack, nil
+}
+
+// LoadFS reads every pack at the top of the file system, in name order.
+func LoadFS(fsys fs.FS) ([]Pack, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var packs []Pack
+	for _, entry := range entries {
+		if entry.IsDir() || !Supported(entry.Name()) {
+			continue
+		}
+
+		file, openErr := fsys.Open(entry.Name())
+		if openErr != nil {
+			err = errors.Join(err, openErr)
+			continue
+		}
+
+		pack, loadErr := Load(entry.Name(), file)
+		_ = file.Close()
+		if loadErr != nil {
+			err = errors.Join(err, loadErr)
+			continue
+		}
+
+		packs = append(packs, pack)
+	}
+
+	return packs, err
+}
+
+// Supported reports whether the file is in a format packs can be written in.
+func Supported(file string) bool {
+	return slices.Contains([]string{".json", ".yaml", ".yml"}, strings.ToLower(path.Ext(file)))
+}
+
+// sections finds the line of every definition in each top-level list of the
+// document.
+func sections(root *yaml.Node) map[string][]int {
+	lines := map[string][]int{}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return lines
+	}
+
+	mapping := root.Content[0].Content
+	for index := 0; index+1 < len(mapping); index += 2 {
+		for _, definition := range mapping[index+1].Content {
+			lines[mapping[index].Value] = append(lines[mapping[index].Value], definition.Line)
+		}
+	}
+
+	return lines
+}
+
+// located turns the YAML decoder's errors into Errors pointing at the lines
+// they were found on.
+func located(file string, err error) error {
+	var typeErr *yaml.TypeError
+	if !errors.As(err, &typeErr) {
+		return atLine(file, strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+
+	problems := make([]error, 0, len(typeErr.Errors))
+	for _, message := range typeErr.Errors {
+		problems = append(problems, atLine(file, message))
+	}
+
+	return errors.Join(problems...)
+}
+
+var linePrefix = regexp.MustCompile(`^line (\d+): `)
+
+func atLine(file, message string) Error {
+	match := linePrefix.FindStringSubmatch(message)
+	if match == nil {
+		return Error{File: file, Message: message}
+	}
+
+	line, _ := strconv.Atoi(match[1])
+
+	return Error{File: file, Line: line, Message: message[len(match[0]):]}
+}
+
+func locate[T any](definitions []T, file string, lines []int, origin func(*T) *Origin) {
+	for index := range definitions {
+		found := origin(&definitions[index])
+		found.File = file
+		if index < len(lines) {
+			found.Line = lines[index]
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package content_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlPack = `name: crypt
+priority: 1
+items:
+  - name: Bone club
+    type: Weapon
+  - name: Grave dust
+    type: Potion
+    rarity: uncommon
+enemies:
+  - kind: Skeleton
+    life: 70
+    damage: {min: 5, max: 20}
+    loot:
+      guaranteed: [Bone club]
+rooms:
+  - name: Ossuary
+    kind: Enemy
+    enemies: [Skeleton]
+    items: [Grave dust]
+dungeons:
+  - name: Crypt
+    rooms: [Ossuary]
+`
+
+const jsonPack = `{
+	"name": "armoury",
+	"items": [
+		{"name": "Sword", "type": "Weapon"},
+		{"id": "old-club", "name": "Bone club", "type": "Weapon", "rarity": "rare"}
+	]
+}`
+
+func TestLoad(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when reading YAML", func(t *testing.T) {
+			pack, err := content.Load("crypt.yaml", strings.NewReader(yamlPack))
+
+			require.NoError(t, err)
+			require.Equal(t, "crypt", pack.Name)
+			require.Equal(t, 1, pack.Priority)
+			require.Equal(t, item.Item{ID: "grave-dust", Name: "Grave dust", Type: item.Potion, Rarity: item.Uncommon}, pack.Items[1].Item)
+			require.Equal(t, content.Origin{File: "crypt.yaml", Line: 6}, pack.Items[1].Origin)
+			require.Equal(t, enemy.Kind("Skeleton"), pack.Enemies[0].Kind)
+			require.Equal(t, content.Range{Min: 5, Max: 20}, pack.Enemies[0].Damage)
+			require.Equal(t, []string{"Bone club"}, pack.Enemies[0].Loot.Guaranteed)
+			require.Equal(t, 16, pack.Rooms[0].Line)
+			require.Equal(t, []string{"Ossuary"}, pack.Dungeons[0].Rooms)
+		})
+
+		t.Run("when reading JSON", func(t *testing.T) {
+			pack, err := content.Load("armoury.json", strings.NewReader(jsonPack))
+
+			require.NoError(t, err)
+			require.Len(t, pack.Items, 2)
+			require.Equal(t, content.Origin{File: "armoury.json", Line: 5}, pack.Items[1].Origin)
+			require.Equal(t, item.ID("old-club"), pack.Items[1].ID)
+		})
+
+		t.Run("when reading every pack in a directory", func(t *testing.T) {
+			packs, err := content.LoadFS(fstest.MapFS{
+				"crypt.yml":    {Data: []byte(yamlPack)},
+				"armoury.json": {Data: []byte(jsonPack)},
+				"README.md":    {Data: []byte("# packs")},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, packs, 2)
+			require.Equal(t, "armoury", packs[0].Name)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the format is not supported", func(t *testing.T) {
+			_, err := content.Load("crypt.toml", strings.NewReader(""))
+
+			require.EqualError(t, err, "crypt.toml: unsupported content format, expected .json, .yaml or .yml")
+		})
+
+		t.Run("when the syntax is broken, pointing at the line", func(t *testing.T) {
+			_, err := content.Load("armoury.json", strings.NewReader("{\n\t\"name\": \"armoury\",\n\t\"items\": [\n\t\t{\"name\": \"Sword\"\n\t]\n}"))
+
+			require.EqualError(t, err, "armoury.json:3: did not find expected ',' or '}'")
+		})
+
+		t.Run("when a field is unknown, pointing at the line", func(t *testing.T) {
+			_, err := content.Load("crypt.yaml", strings.NewReader("name: crypt\nitems:\n  - name: Sword\n    colour: red\n"))
+
+			require.EqualError(t, err, "crypt.yaml:4: items[0]: unknown field \"colour\"\n"+
+				"crypt.yaml:3: items[0]: missing \"type\"")
+		})
+
+		t.Run("when values break the schema, pointing at each", func(t *testing.T) {
+			_, err := content.Load("crypt.yaml", strings.NewReader(`name: crypt
+items:
+  - {name: Amulet, type: Jewel}
+enemies:
+  - {kind: Ghast, life: 0, damage: {min: 1, max: many}}
+rooms:
+  - {name: Vault, kind: Treasure, modifiers: [haunted]}
+dungeons: Crypt
+`))
+
+			require.EqualError(t, err, strings.Join([]string{
+				`crypt.yaml:3: items[0].type: "Jewel" is not one of Weapon, Armour, Potion, Key, Torch, Kit, Material`,
+				`crypt.yaml:5: enemies[0].life: 0 is below the minimum of 1`,
+				`crypt.yaml:5: enemies[0].damage.max: expected integer, found string`,
+				`crypt.yaml:7: rooms[0].modifiers[0]: "haunted" is not one of cursed, dark, flooded, blessed`,
+				`crypt.yaml:8: dungeons: expected array, found string`,
+			}, "\n"))
+		})
+
+		t.Run("when the pack is empty", func(t *testing.T) {
+			_, err := content.Load("crypt.yaml", strings.NewReader(""))
+
+			require.EqualError(t, err, "crypt.yaml: pack is empty")
+		})
+	})
+}
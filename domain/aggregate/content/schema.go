@@ -0,0 +1,188 @@
+package content
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Schema is the JSON Schema every pack is checked against, published for
+// authors to point their editors at.
+//
+//go:embed schema.json
+var Schema []byte
+
+var published = func() *schema {
+	parsed := &schema{}
+	if err := json.Unmarshal(Schema, parsed); err != nil {
+		panic(fmt.Sprintf("content schema: %v", err))
+	}
+
+	return parsed
+}()
+
+// schema is the part of JSON Schema packs are described with.
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Defs                 map[string]*schema `json:"$defs"`
+	Type                 string             `json:"type"`
+	Enum                 []string           `json:"enum"`
+	Minimum              *int               `json:"minimum"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	PropertyNames        *schema            `json:"propertyNames"`
+	Items                *schema            `json:"items"`
+	// forbidden is the false schema, which nothing conforms to.
+	forbidden bool
+}
+
+func (s *schema) UnmarshalJSON(data []byte) error {
+	var allowed bool
+	if json.Unmarshal(data, &allowed) == nil {
+		s.forbidden = !allowed
+		return nil
+	}
+
+	type plain schema
+
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// conform checks the document against the published schema, reporting every
+// value that breaks it at the line the value is on.
+func conform(file string, root *yaml.Node) error {
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		return Error{File: file, Message: "pack is empty"}
+	}
+
+	document := root.Content[0]
+
+	check := &conformance{file: file}
+	check.value(published, document, "")
+
+	return errors.Join(check.problems...)
+}
+
+type conformance struct {
+	file     string
+	problems []error
+}
+
+func (check *conformance) value(s *schema, node *yaml.Node, at string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if s.Ref != "" {
+		s = published.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]
+		if s == nil {
+			return
+		}
+	}
+
+	found := typeOf(node)
+	if s.Type != "" && found != s.Type && (s.Type != "number" || found != "integer") {
+		check.report(node, "%s: expected %s, found %s", label(at), s.Type, found)
+		return
+	}
+
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, node.Value) {
+		check.report(node, "%s: %q is not one of %s", label(at), node.Value, strings.Join(s.Enum, ", "))
+	}
+
+	if s.Minimum != nil && found == "integer" {
+		if number, err := strconv.Atoi(node.Value); err == nil && number < *s.Minimum {
+			check.report(node, "%s: %d is below the minimum of %d", label(at), number, *s.Minimum)
+		}
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		check.object(s, node, at)
+	case yaml.SequenceNode:
+		if s.Items != nil {
+			for index, element := range node.Content {
+				check.value(s.Items, element, fmt.Sprintf("%s[%d]", at, index))
+			}
+		}
+	}
+}
+
+func (check *conformance) object(s *schema, node *yaml.Node, at string) {
+	var seen []string
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		key, value := node.Content[index], node.Content[index+1]
+		seen = append(seen, key.Value)
+
+		if s.PropertyNames != nil {
+			check.value(s.PropertyNames, key, at)
+		}
+
+		property, ok := s.Properties[key.Value]
+		switch {
+		case ok:
+			check.value(property, value, field(at, key.Value))
+		case s.AdditionalProperties != nil && s.AdditionalProperties.forbidden:
+			check.report(key, "%s: unknown field %q", label(at), key.Value)
+		case s.AdditionalProperties != nil:
+			check.value(s.AdditionalProperties, value, field(at, key.Value))
+		}
+	}
+
+	for _, name := range s.Required {
+		if !slices.Contains(seen, name) {
+			check.report(node, "%s: missing %q", label(at), name)
+		}
+	}
+}
+
+func (check *conformance) report(node *yaml.Node, format string, args ...any) {
+	check.problems = append(check.problems, Error{File: check.file, Line: node.Line, Message: fmt.Sprintf(format, args...)})
+}
+
+// typeOf names the JSON type of the node.
+func typeOf(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+
+	switch node.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	default:
+		return "string"
+	}
+}
+
+func field(at, name string) string {
+	if at == "" {
+		return name
+	}
+
+	return at + "." + name
+}
+
+// label is how a path into the pack reads in a problem.
+func label(at string) string {
+	if at == "" {
+		return "pack"
+	}
+
+	return at
+}
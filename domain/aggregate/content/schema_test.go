@@ -0,0 +1,55 @@
+package content_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	var schema struct {
+		Defs map[string]struct {
+			Enum       []string `json:"enum"`
+			Properties map[string]struct {
+				Enum  []string `json:"enum"`
+				Items struct {
+					Enum []string `json:"enum"`
+				} `json:"items"`
+			} `json:"properties"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(content.Schema, &schema))
+
+	strings := func(t *testing.T, values any) []string {
+		encoded, err := json.Marshal(values)
+		require.NoError(t, err)
+
+		var decoded []string
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+		return decoded
+	}
+
+	t.Run("lists every item type", func(t *testing.T) {
+		require.Equal(t, strings(t, item.Types()), schema.Defs["item"].Properties["type"].Enum)
+		require.Equal(t, strings(t, item.Types()), schema.Defs["affix"].Properties["types"].Items.Enum)
+	})
+
+	t.Run("lists every rarity", func(t *testing.T) {
+		require.Equal(t, strings(t, item.Rarities()), schema.Defs["item"].Properties["rarity"].Enum)
+	})
+
+	t.Run("lists every element", func(t *testing.T) {
+		require.Equal(t, strings(t, element.Elements()), schema.Defs["element"].Enum)
+	})
+
+	t.Run("lists every room kind and modifier", func(t *testing.T) {
+		require.ElementsMatch(t, strings(t, room.Kinds()), schema.Defs["room"].Properties["kind"].Enum)
+		require.Equal(t, strings(t, room.Modifiers()), schema.Defs["room"].Properties["modifiers"].Items.Enum)
+	})
+}
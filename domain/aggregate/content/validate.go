@@ -0,0 +1,275 @@
+package content
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+)
+
+// Validate checks every definition in the library and that they refer to one
+// another correctly, reporting each problem as an Error pointing at the
+// definition it was found in. Definitions sharing a name within one pack are
+// reported too, as only the last of them is kept. Dungeons are built to prove they can be
+// completed with every room reached.
+func (library *Library) Validate() error {
+	problems := slices.Clone(library.duplicates)
+	ids := map[item.ID]string{}
+	for _, name := range slices.Sorted(maps.Keys(library.Items)) {
+		definition := library.Items[name]
+		problems = append(problems, library.validateItem(definition)...)
+		if other, ok := ids[definition.ID]; ok {
+			problems = append(problems, definition.errorf("item %q: id %q is already used by %q", name, definition.ID, other))
+		}
+		ids[definition.ID] = name
+	}
+	for _, name := range slices.Sorted(maps.Keys(library.Affixes)) {
+		problems = append(problems, library.validateAffix(library.Affixes[name])...)
+	}
+	for _, kind := range slices.Sorted(maps.Keys(library.Enemies)) {
+		problems = append(problems, library.validateEnemy(library.Enemies[kind])...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(library.Puzzles)) {
+		definition := library.Puzzles[name]
+		if _, err := definition.Build(); err != nil {
+			problems = append(problems, definition.errorf("puzzle %q: %v", name, err))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(library.Rooms)) {
+		problems = append(problems, library.validateRoom(library.Rooms[name])...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(library.Dungeons)) {
+		problems = append(problems, library.validateDungeon(library.Dungeons[name])...)
+	}
+	for _, name := range slices.Sorted(maps.Keys(library.Recipes)) {
+		problems = append(problems, library.validateRecipe(library.Recipes[name])...)
+	}
+
+	return errors.Join(problems...)
+}
+
+// Unplaced reports every room template no dungeon walks through, which the
+// player can never reach. Packs may define rooms for other packs' dungeons,
+// so these are only problems for the library as a whole.
+func (library *Library) Unplaced() error {
+	var problems []error
+	for _, name := range slices.Sorted(maps.Keys(library.Rooms)) {
+		if !library.placed(name) {
+			problems = append(problems, library.Rooms[name].errorf("room %q: unreachable, no dungeon leads to it", name))
+		}
+	}
+
+	return errors.Join(problems...)
+}
+
+func (library *Library) validateItem(definition Item) []error {
+	var problems []error
+	if definition.Name == "" {
+		problems = append(problems, definition.errorf("item needs a name"))
+	}
+	if !slices.Contains(item.Types(), definition.Type) {
+		problems = append(problems, definition.errorf("item %q: unknown type %q", definition.Name, definition.Type))
+	}
+	if definition.Rarity != "" && !slices.Contains(item.Rarities(), definition.Rarity) {
+		problems = append(problems, definition.errorf("item %q: unknown rarity %q", definition.Name, definition.Rarity))
+	}
+	if definition.Element != "" && !slices.Contains(element.Elements(), definition.Element) {
+		problems = append(problems, definition.errorf("item %q: unknown element %q", definition.Name, definition.Element))
+	}
+
+	return problems
+}
+
+func (library *Library) validateAffix(definition Affix) []error {
+	var problems []error
+	if definition.Name == "" {
+		problems = append(problems, definition.errorf("affix needs a name"))
+	}
+	if definition.Position != item.Prefix && definition.Position != item.Suffix {
+		problems = append(problems, definition.errorf("affix %q: unknown position %q", definition.Name, definition.Position))
+	}
+	for _, kind := range definition.Types {
+		if !slices.Contains(item.Types(), kind) {
+			problems = append(problems, definition.errorf("affix %q: unknown item type %q", definition.Name, kind))
+		}
+	}
+
+	return problems
+}
+
+func (library *Library) validateEnemy(definition Enemy) []error {
+	var problems []error
+	if definition.Kind == "" {
+		problems = append(problems, definition.errorf("enemy needs a kind"))
+	}
+	if definition.Life <= 0 {
+		problems = append(problems, definition.errorf("enemy %q: needs some life", definition.Kind))
+	}
+	if definition.Damage.Min < 0 || definition.Damage.Max <= definition.Damage.Min {
+		problems = append(problems, definition.errorf("enemy %q: damage range is invalid", definition.Kind))
+	}
+	for _, id := range definition.Abilities {
+		if _, err := ability.Lookup(id); err != nil {
+			problems = append(problems, definition.errorf("enemy %q: unknown ability %q", definition.Kind, id))
+		}
+	}
+	if definition.Loot != nil {
+		if _, err := library.table(*definition.Loot); err != nil {
+			problems = append(problems, definition.errorf("enemy %q: loot: %v", definition.Kind, err))
+		}
+	}
+
+	return problems
+}
+
+func (library *Library) validateRoom(definition Room) []error {
+	var problems []error
+	if definition.Name == "" {
+		problems = append(problems, definition.errorf("room needs a name"))
+	}
+	if !slices.Contains(room.Kinds(), definition.Kind) {
+		problems = append(problems, definition.errorf("room %q: unknown kind %q", definition.Name, definition.Kind))
+	}
+	if _, err := library.items(definition.Items); err != nil {
+		problems = append(problems, definition.errorf("room %q: %v", definition.Name, err))
+	}
+	for _, kind := range append(slices.Clone(definition.Enemies), definition.Boss) {
+		if kind != "" && !library.knows(kind) {
+			problems = append(problems, definition.errorf("room %q: unknown enemy %q", definition.Name, kind))
+		}
+	}
+	if definition.Puzzle != "" {
+		if _, ok := library.Puzzles[definition.Puzzle]; !ok {
+			problems = append(problems, definition.errorf("room %q: unknown puzzle %q", definition.Name, definition.Puzzle))
+		}
+	}
+	for _, name := range definition.Hazards {
+		if _, ok := trap.Lookup(name); !ok {
+			problems = append(problems, definition.errorf("room %q: unknown hazard %q", definition.Name, name))
+		}
+	}
+	if definition.Merchant != nil {
+		if _, err := library.merchant(*definition.Merchant); err != nil {
+			problems = append(problems, definition.errorf("room %q: merchant: %v", definition.Name, err))
+		}
+	}
+	for _, modifier := range definition.Modifiers {
+		if !slices.Contains(room.Modifiers(), modifier) {
+			problems = append(problems, definition.errorf("room %q: unknown modifier %q", definition.Name, modifier))
+		}
+	}
+
+	if len(problems) == 0 {
+		if _, err := library.room(definition.Name, firstPick{}); err != nil {
+			problems = append(problems, definition.errorf("%v", err))
+		}
+	}
+
+	return problems
+}
+
+func (library *Library) validateDungeon(definition Dungeon) []error {
+	var problems []error
+	if len(definition.Rooms) == 0 {
+		problems = append(problems, definition.errorf("dungeon %q: has no rooms", definition.Name))
+	}
+	for _, name := range definition.Rooms {
+		if _, ok := library.Rooms[name]; !ok {
+			problems = append(problems, definition.errorf("dungeon %q: unknown room %q", definition.Name, name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+
+	state := &game.State{}
+	if err := library.Build(definition.Name, state, firstPick{}); err != nil {
+		// The broken room template is reported on its own.
+		return nil
+	}
+
+	for _, problem := range flatten(state.Validate()) {
+		problems = append(problems, definition.errorf("dungeon %q: %v", definition.Name, problem))
+	}
+	for index := state.Reachable(); index < len(definition.Rooms); index++ {
+		problems = append(problems, definition.errorf("dungeon %q: room %d (%s) is unreachable", definition.Name, index, definition.Rooms[index]))
+	}
+
+	return problems
+}
+
+func (library *Library) validateRecipe(definition Recipe) []error {
+	var problems []error
+	if definition.Name == "" {
+		problems = append(problems, definition.errorf("recipe needs a name"))
+	}
+	if len(definition.Ingredients) == 0 {
+		problems = append(problems, definition.errorf("recipe %q: needs ingredients", definition.Name))
+	}
+	for _, ingredient := range definition.Ingredients {
+		if _, ok := library.Items[ingredient.Item]; !ok {
+			problems = append(problems, definition.errorf("recipe %q: unknown ingredient %q", definition.Name, ingredient.Item))
+		}
+		if ingredient.Count < 0 {
+			problems = append(problems, definition.errorf("recipe %q: needs at least one %s", definition.Name, ingredient.Item))
+		}
+	}
+	if _, ok := library.Items[definition.Result]; !ok {
+		problems = append(problems, definition.errorf("recipe %q: unknown result %q", definition.Name, definition.Result))
+	}
+
+	return problems
+}
+
+// flatten splits joined errors into the errors they were joined from.
+func flatten(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		if err == nil {
+			return nil
+		}
+
+		return []error{err}
+	}
+
+	var errs []error
+	for _, inner := range joined.Unwrap() {
+		errs = append(errs, flatten(inner)...)
+	}
+
+	return errs
+}
+
+// placed reports whether any dungeon walks through the room template.
+func (library *Library) placed(name string) bool {
+	for _, dungeon := range library.Dungeons {
+		if slices.Contains(dungeon.Rooms, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// knows reports whether the enemy kind is defined in the library or built in.
+func (library *Library) knows(kind enemy.Kind) bool {
+	_, ok := library.Enemies[kind]
+
+	return ok || slices.Contains(enemy.Kinds(), kind)
+}
+
+// firstPick always picks the first choice, so validation builds the same
+// dungeon every time.
+type firstPick struct{}
+
+func (firstPick) Intn(int) int {
+	return 0
+}
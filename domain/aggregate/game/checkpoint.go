@@ -0,0 +1,146 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+)
+
+// Checkpoint is a snapshot of the player, and of every room, taken in a room,
+// which death rolls the game back to.
+type Checkpoint struct {
+	Room   int            `json:"room"`
+	Player *player.Player `json:"player"`
+	Rooms  []Chamber      `json:"rooms"`
+}
+
+// Chamber is what a checkpoint holds of a room: the items left in it, its
+// enemies and the phase of its boss, the stock of its merchant and the
+// attempts spent on its puzzle. Traps stay sprung or disarmed and lit rooms
+// stay lit.
+type Chamber struct {
+	Items    []item.Item   `json:"items"`
+	Enemies  []enemy.Enemy `json:"enemies,omitempty"`
+	Stage    int           `json:"stage,omitempty"`
+	Stock    []int         `json:"stock,omitempty"`
+	Attempts int           `json:"attempts,omitempty"`
+	Solved   bool          `json:"solved,omitempty"`
+}
+
+var noCheckpoint = errors.New("no checkpoint")
+
+// SaveCheckpoint snapshots the player, and what the rooms hold, in the room
+// at the given index and writes it to path, replacing any earlier checkpoint.
+func (state *State) SaveCheckpoint(room int, path string) error {
+	if state.Player == nil {
+		return errors.New("player cannot be nil")
+	}
+
+	chambers := make([]Chamber, 0, len(state.Rooms))
+	for _, chamber := range state.Rooms {
+		chambers = append(chambers, snapshot(chamber))
+	}
+
+	content, err := json.MarshalIndent(Checkpoint{Room: room, Player: state.Player, Rooms: chambers}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+// Restore rolls the player back to the checkpoint at path and returns the
+// index of the room it was taken in. A checkpoint can only be restored once.
+// The player and the enemies are restored in place so everything holding on
+// to them sees the rollback, and the rooms get back what they held, so
+// nothing the player carried when they died, such as a key, is lost.
+func (state *State) Restore(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, noCheckpoint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	checkpoint := Checkpoint{}
+	if err = json.Unmarshal(content, &checkpoint); err != nil {
+		return 0, err
+	}
+
+	if checkpoint.Player == nil {
+		return 0, noCheckpoint
+	}
+
+	if state.Player == nil {
+		state.Player = checkpoint.Player
+	} else {
+		*state.Player = *checkpoint.Player
+	}
+
+	if len(checkpoint.Rooms) == len(state.Rooms) {
+		for index, chamber := range state.Rooms {
+			rewind(chamber, checkpoint.Rooms[index])
+		}
+	}
+
+	return checkpoint.Room, os.Remove(path)
+}
+
+// snapshot records what the room holds.
+func snapshot(chamber room.Room) Chamber {
+	saved := Chamber{Items: chamber.Items()}
+	for _, foe := range chamber.Enemies() {
+		saved.Enemies = append(saved.Enemies, *foe)
+	}
+
+	switch base := room.Base(chamber).(type) {
+	case room.Lair:
+		saved.Stage = base.Boss().Stage()
+	case room.Market:
+		for _, listing := range base.Merchant().Listings {
+			saved.Stock = append(saved.Stock, listing.Stock)
+		}
+	case room.Enigma:
+		saved.Attempts, saved.Solved = base.Attempts(), base.Solved()
+	}
+
+	return saved
+}
+
+// rewind puts back what the room held when the snapshot was taken.
+func rewind(chamber room.Room, saved Chamber) {
+	chamber.Take()
+	chamber.Drop(saved.Items...)
+
+	switch base := room.Base(chamber).(type) {
+	case room.Lair:
+		base.Boss().Rewind(saved.Stage)
+	case room.Market:
+		listings := base.Merchant().Listings
+		if len(listings) == len(saved.Stock) {
+			for index, listing := range listings {
+				listing.Stock = saved.Stock[index]
+			}
+		}
+	case room.Enigma:
+		base.Rewind(saved.Attempts, saved.Solved)
+	}
+
+	foes := chamber.Enemies()
+	if len(foes) == len(saved.Enemies) {
+		for index, foe := range foes {
+			*foe = saved.Enemies[index]
+		}
+	}
+}
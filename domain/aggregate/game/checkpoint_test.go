@@ -0,0 +1,140 @@
+package game_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when rolling back to a saved checkpoint", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "checkpoint.json")
+			state := &game.State{Player: player.NewWithClass("Elmster", player.Warrior)}
+			sword := item.Item{Name: "Sword", Type: item.Weapon}
+			state.Player.Collect(sword)
+			require.NoError(t, state.Player.Equip(sword))
+			state.Player.ApplyEffect(effect.New(effect.Poison, "Goblin"))
+			saved := *state.Player
+
+			require.NoError(t, state.SaveCheckpoint(3, path))
+
+			held := state.Player
+			held.Life.Value = 0
+			held.Gold = 99
+
+			room, err := state.Restore(path)
+
+			require.NoError(t, err)
+			require.Equal(t, 3, room)
+			require.Same(t, held, state.Player, "restores the player in place")
+			require.Equal(t, saved.Life, state.Player.Life)
+			require.Equal(t, saved.Equipment, state.Player.Equipment)
+			require.Equal(t, saved.Effects, state.Player.Effects)
+			require.Equal(t, *saved.Class, *state.Player.Class)
+			require.Zero(t, state.Player.Gold)
+		})
+
+		t.Run("when items were taken from the rooms after the checkpoint", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "checkpoint.json")
+			key := item.Item{Name: "Iron key", Type: item.Key}
+			state := &game.State{
+				Player: player.New("Elmster"),
+				Rooms:  []room.Room{room.Factory(room.FactoryInput{Kind: room.KindTreasure}), room.Factory(room.FactoryInput{Kind: room.KindTreasure, Items: []item.Item{key}})},
+			}
+			require.NoError(t, state.SaveCheckpoint(0, path))
+
+			for _, treasure := range state.Rooms[1].Take() {
+				state.Player.Collect(treasure)
+			}
+			state.Rooms[0].Drop(item.Item{Name: "Sword", Type: item.Weapon})
+
+			_, err := state.Restore(path)
+
+			require.NoError(t, err)
+			require.Empty(t, state.Player.Inventory)
+			require.Empty(t, state.Rooms[0].Items())
+			require.Equal(t, []item.Item{key}, state.Rooms[1].Items())
+		})
+
+		t.Run("when the rooms were played through after the checkpoint", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "checkpoint.json")
+			gem := item.Item{Name: "Gem", Type: item.Material}
+			goblin := enemy.New(enemy.Goblin)
+			dragon := enemy.NewBoss(enemy.Dragon)
+			merchant := shop.GeneralStore()
+			state := &game.State{
+				Player: player.New("Elmster"),
+				Rooms: []room.Room{
+					room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &puzzle.Definition{Name: "Vault", Kind: puzzle.KindCombination, Code: "427", Attempts: 3, Reward: []item.Item{gem}}}),
+					room.Factory(room.FactoryInput{Kind: room.KindEnemy, Enemies: []*enemy.Enemy{goblin}}),
+					room.Factory(room.FactoryInput{Kind: room.KindShop, Merchant: merchant}),
+					room.Factory(room.FactoryInput{Kind: room.KindBoss, Boss: dragon}),
+				},
+			}
+			stock := merchant.Listings[0].Stock
+			require.NoError(t, state.SaveCheckpoint(0, path))
+
+			vault := state.Rooms[0].(room.Enigma)
+			_, err := vault.Attempt("111")
+			require.NoError(t, err)
+			result, err := vault.Attempt("427")
+			require.NoError(t, err)
+			require.True(t, result.Solved)
+			goblin.Life.Value = 0
+			merchant.Listings[0].Stock = 0
+			dragon.Life.Value = 10
+			_, advanced := dragon.Advance()
+			require.True(t, advanced)
+
+			_, err = state.Restore(path)
+
+			require.NoError(t, err)
+			require.False(t, vault.Solved())
+			require.Zero(t, vault.Attempts())
+			require.True(t, vault.Locked())
+			require.Empty(t, vault.Items(), "the reward is gone until the puzzle is solved again")
+			require.Same(t, goblin, state.Rooms[1].Enemies()[0], "restores the enemies in place")
+			require.Equal(t, 100, goblin.Health())
+			require.Equal(t, stock, merchant.Listings[0].Stock)
+			require.Zero(t, dragon.Stage())
+			require.Equal(t, 300, dragon.Health())
+			require.Equal(t, "Awakened", dragon.Phase().Name)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when there is no checkpoint", func(t *testing.T) {
+			state := &game.State{Player: player.New("Elmster")}
+
+			_, err := state.Restore(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+			require.EqualError(t, err, "no checkpoint")
+		})
+
+		t.Run("when the checkpoint was already restored", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "checkpoint.json")
+			state := &game.State{Player: player.New("Elmster")}
+			require.NoError(t, state.SaveCheckpoint(1, path))
+
+			_, err := state.Restore(path)
+			require.NoError(t, err)
+
+			_, err = state.Restore(path)
+			require.EqualError(t, err, "no checkpoint")
+		})
+
+		t.Run("when there is no player to save", func(t *testing.T) {
+			require.EqualError(t, (&game.State{}).SaveCheckpoint(1, filepath.Join(t.TempDir(), "c.json")), "player cannot be nil")
+		})
+	})
+}
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Seal is what keeps a door shut.
+type Seal string
+
+const (
+	SealKey    Seal = "key"
+	SealPuzzle Seal = "puzzle"
+	SealBoss   Seal = "boss"
+)
+
+// Door shuts the way into a room. A key door opens for a player carrying the
+// key named Key, while puzzle and boss doors open once the puzzle posed in
+// the room at index Room is solved or the boss there is defeated.
+type Door struct {
+	Seal Seal
+	Key  string
+	Room int
+}
+
+var invalidSeal = errors.New("invalid seal")
+
+// Open opens the door leading into the room at the index, failing when the
+// player has not met its condition. Rooms without a door are always open.
+func (state *State) Open(index int) error {
+	door, ok := state.Doors[index]
+	if !ok {
+		return nil
+	}
+
+	switch door.Seal {
+	case SealKey:
+		if state.Player == nil || !slices.ContainsFunc(state.Player.Inventory, opens(door.Key)) {
+			return fmt.Errorf("door needs the %s", door.Key)
+		}
+	case SealPuzzle:
+		enigma, ok := room.Base(state.room(door.Room)).(room.Enigma)
+		if !ok || !enigma.Solved() {
+			return errors.New("door waits on an unsolved puzzle")
+		}
+	case SealBoss:
+		lair, ok := room.Base(state.room(door.Room)).(room.Lair)
+		if !ok || lair.Boss().Health() > 0 {
+			return errors.New("door waits on an undefeated boss")
+		}
+	default:
+		return invalidSeal
+	}
+
+	return nil
+}
+
+func (state *State) room(index int) room.Room {
+	if index < 0 || index >= len(state.Rooms) {
+		return nil
+	}
+
+	return state.Rooms[index]
+}
+
+// opens matches the key of the given name.
+func opens(key string) func(item.Item) bool {
+	return func(candidate item.Item) bool {
+		return candidate.Type == item.Key && candidate.Name == key
+	}
+}
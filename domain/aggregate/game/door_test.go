@@ -0,0 +1,105 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/stretchr/testify/require"
+)
+
+var riddle = puzzle.Definition{
+	Name:     "Sphinx",
+	Kind:     puzzle.KindRiddle,
+	Prompt:   "What has keys but opens no locks?",
+	Answers:  []string{"piano"},
+	Attempts: 1,
+}
+
+func TestOpen(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the room has no door", func(t *testing.T) {
+			state := &game.State{Player: player.New("Elmster")}
+
+			require.NoError(t, state.Open(0))
+		})
+
+		t.Run("when the player carries the key", func(t *testing.T) {
+			state := &game.State{
+				Player: player.New("Elmster"),
+				Doors:  map[int]game.Door{1: {Seal: game.SealKey, Key: "Iron key"}},
+			}
+
+			require.EqualError(t, state.Open(1), "door needs the Iron key")
+
+			state.Player.Collect(item.Item{Name: "Iron key", Type: item.Key, Rarity: item.Rare})
+
+			require.NoError(t, state.Open(1))
+		})
+
+		t.Run("when the puzzle is solved", func(t *testing.T) {
+			sphinx := room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &riddle})
+			state := &game.State{
+				Rooms: []room.Room{sphinx},
+				Doors: map[int]game.Door{1: {Seal: game.SealPuzzle, Room: 0}},
+			}
+
+			require.EqualError(t, state.Open(1), "door waits on an unsolved puzzle")
+
+			_, err := sphinx.(room.Enigma).Attempt("piano")
+			require.NoError(t, err)
+
+			require.NoError(t, state.Open(1))
+		})
+
+		t.Run("when the boss is defeated", func(t *testing.T) {
+			boss := enemy.NewBoss(enemy.Dragon)
+			state := &game.State{
+				Rooms: []room.Room{room.Factory(room.FactoryInput{Kind: room.KindBoss, Boss: boss})},
+				Doors: map[int]game.Door{1: {Seal: game.SealBoss, Room: 0}},
+			}
+
+			require.EqualError(t, state.Open(1), "door waits on an undefeated boss")
+
+			boss.Life.Value = 0
+
+			require.NoError(t, state.Open(1))
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the player carries a different item of the same name", func(t *testing.T) {
+			state := &game.State{
+				Player: player.New("Elmster"),
+				Doors:  map[int]game.Door{0: {Seal: game.SealKey, Key: "Iron key"}},
+			}
+			state.Player.Collect(item.Item{Name: "Iron key", Type: item.Weapon})
+
+			require.EqualError(t, state.Open(0), "door needs the Iron key")
+		})
+
+		t.Run("when a puzzle failed for good", func(t *testing.T) {
+			sphinx := room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &riddle})
+			state := &game.State{
+				Rooms: []room.Room{sphinx},
+				Doors: map[int]game.Door{1: {Seal: game.SealPuzzle, Room: 0}},
+			}
+
+			_, err := sphinx.(room.Enigma).Attempt("door")
+			require.NoError(t, err)
+			require.False(t, sphinx.Locked())
+
+			require.EqualError(t, state.Open(1), "door waits on an unsolved puzzle")
+		})
+
+		t.Run("when the seal is unknown", func(t *testing.T) {
+			state := &game.State{Doors: map[int]game.Door{0: {Seal: "charm"}}}
+
+			require.EqualError(t, state.Open(0), "invalid seal")
+		})
+	})
+}
@@ -0,0 +1,42 @@
+package game
+
+import (
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+// Look is what the player sees of the room at the index: its scene, the
+// enemies still standing and, unless it is too dark, the items lying around.
+func (state *State) Look(index int) event.View {
+	chamber := state.room(index)
+	if chamber == nil {
+		return event.View{Kind: event.RoomViewed}
+	}
+
+	described := chamber.Scene()
+	view := event.View{
+		Kind:        event.RoomViewed,
+		Name:        described.Name,
+		Description: described.Description,
+		Features:    described.Features,
+		Lighting:    string(described.OrBright()),
+		Dark:        chamber.Dark(),
+	}
+
+	if state.Player != nil {
+		view.Player = state.Player.Name
+	}
+
+	for _, foe := range chamber.Enemies() {
+		if foe.Health() > 0 {
+			view.Enemies = append(view.Enemies, foe.String())
+		}
+	}
+
+	if !view.Dark {
+		for _, found := range chamber.Items() {
+			view.Items = append(view.Items, found.Name)
+		}
+	}
+
+	return view
+}
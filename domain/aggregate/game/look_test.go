@@ -0,0 +1,63 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLook(t *testing.T) {
+	cellar := func() *game.State {
+		fallen := enemy.New(enemy.Orc)
+		fallen.Life.Value = 0
+
+		return &game.State{
+			Player: player.New("Elmster"),
+			Rooms: []room.Room{room.Factory(room.FactoryInput{
+				Kind:    room.KindEnemy,
+				Items:   []item.Item{{Name: "Potion", Type: item.Potion}},
+				Enemies: []*enemy.Enemy{enemy.New(enemy.Goblin), fallen},
+				Scene: scene.Scene{
+					Name:        "Cellar",
+					Description: "Barrels rot in the damp.",
+					Features:    []string{"a rusted grate"},
+					Lighting:    scene.Dark,
+				},
+			})},
+		}
+	}
+
+	t.Run("hides the items of a dark room", func(t *testing.T) {
+		require.Equal(t, event.View{
+			Kind:        event.RoomViewed,
+			Player:      "Elmster",
+			Name:        "Cellar",
+			Description: "Barrels rot in the damp.",
+			Features:    []string{"a rusted grate"},
+			Lighting:    "dark",
+			Dark:        true,
+			Enemies:     []string{"Goblin"},
+		}, cellar().Look(0))
+	})
+
+	t.Run("shows the items once the room is lit", func(t *testing.T) {
+		state := cellar()
+		state.Rooms[0].Light()
+
+		view := state.Look(0)
+
+		require.False(t, view.Dark)
+		require.Equal(t, []string{"Potion"}, view.Items)
+	})
+
+	t.Run("sees nothing of a room that does not exist", func(t *testing.T) {
+		require.Equal(t, event.View{Kind: event.RoomViewed}, cellar().Look(3))
+	})
+}
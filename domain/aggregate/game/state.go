@@ -10,8 +10,10 @@ import (
 var state *State = nil
 
 type State struct {
-	Player       *player.Player
-	Rooms        []room.Room
+	Player *player.Player
+	Rooms  []room.Room
+	// Doors holds the door leading into a room, by the room's index.
+	Doors        map[int]Door
 	Notifier     observer.Notifier
 	IsPlayerTurn bool
 }
@@ -0,0 +1,189 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Validate proves the dungeon can be completed by walking its rooms in order:
+// every door must lead into a room and open with a key the player starts
+// with, finds lying in a room before it or buys from a merchant before it, or
+// on a puzzle or boss the player meets before reaching it. Keys lying in dark
+// rooms are only found with a torch to light them, each of which burns out.
+// Every problem found is reported.
+func (state *State) Validate() error {
+	var err error
+	found := state.supplies()
+	for index, chamber := range state.Rooms {
+		if chamber == nil {
+			err = errors.Join(err, fmt.Errorf("room %d: missing", index))
+			continue
+		}
+
+		if door, ok := state.Doors[index]; ok {
+			err = errors.Join(err, state.validateDoor(index, door, found))
+		}
+
+		found.search(chamber)
+	}
+
+	for _, index := range slices.Sorted(maps.Keys(state.Doors)) {
+		if index < 0 || index >= len(state.Rooms) {
+			err = errors.Join(err, fmt.Errorf("door %d: leads nowhere", index))
+		}
+	}
+
+	return err
+}
+
+// Reachable counts the rooms the player can reach by walking the dungeon in
+// order, stopping at the first missing room or door that can never open.
+func (state *State) Reachable() int {
+	found := state.supplies()
+	for index, chamber := range state.Rooms {
+		if chamber == nil {
+			return index
+		}
+
+		if door, ok := state.Doors[index]; ok && state.validateDoor(index, door, found) != nil {
+			return index
+		}
+
+		found.search(chamber)
+	}
+
+	return len(state.Rooms)
+}
+
+func (state *State) validateDoor(index int, door Door, found *supplies) error {
+	switch door.Seal {
+	case SealKey:
+		if slices.Contains(found.keys, door.Key) {
+			return nil
+		}
+
+		for _, chamber := range state.Rooms[:index] {
+			if chamber != nil && slices.ContainsFunc(chamber.Items(), opens(door.Key)) {
+				return fmt.Errorf("door %d: %s lies in the dark with no torch to light it", index, door.Key)
+			}
+		}
+
+		for _, chamber := range state.Rooms[index:] {
+			if chamber != nil && (slices.ContainsFunc(chamber.Items(), opens(door.Key)) || slices.ContainsFunc(stocked(chamber), opens(door.Key))) {
+				return fmt.Errorf("door %d: %s is locked behind its own door", index, door.Key)
+			}
+		}
+
+		return fmt.Errorf("door %d: %s is nowhere in the dungeon", index, door.Key)
+	case SealPuzzle:
+		if _, ok := room.Base(state.room(door.Room)).(room.Enigma); !ok {
+			return fmt.Errorf("door %d: room %d poses no puzzle", index, door.Room)
+		}
+	case SealBoss:
+		if _, ok := room.Base(state.room(door.Room)).(room.Lair); !ok {
+			return fmt.Errorf("door %d: room %d hosts no boss", index, door.Room)
+		}
+	default:
+		return fmt.Errorf("door %d: %w", index, invalidSeal)
+	}
+
+	if door.Room >= index {
+		return fmt.Errorf("door %d: opens from room %d, behind it", index, door.Room)
+	}
+
+	return nil
+}
+
+// supplies are the keys and torches the player can get hold of walking the
+// dungeon in order.
+type supplies struct {
+	keys    []string
+	torches int
+	// sellsTorches is whether a merchant met along the way sells torches, so
+	// the player never runs out of them.
+	sellsTorches bool
+}
+
+// supplies starts off with what the player carries into the dungeon.
+func (state *State) supplies() *supplies {
+	found := &supplies{}
+	if state.Player != nil {
+		found.gather(state.Player.Inventory)
+	}
+
+	return found
+}
+
+// search gathers what the room's merchant sells and what lies in the room,
+// burning a torch to light the room when it is dark and holds a key or a
+// torch.
+func (found *supplies) search(chamber room.Room) {
+	for _, listed := range stocked(chamber) {
+		switch listed.Type {
+		case item.Key:
+			found.keys = append(found.keys, listed.Name)
+		case item.Torch:
+			found.sellsTorches = true
+		}
+	}
+
+	items := chamber.Items()
+	if !slices.ContainsFunc(items, func(lying item.Item) bool { return lying.Type == item.Key || lying.Type == item.Torch }) {
+		return
+	}
+
+	if chamber.Dark() && !found.light() {
+		return
+	}
+
+	found.gather(items)
+}
+
+// gather picks up the keys and torches among the items.
+func (found *supplies) gather(items []item.Item) {
+	for _, picked := range items {
+		switch picked.Type {
+		case item.Key:
+			found.keys = append(found.keys, picked.Name)
+		case item.Torch:
+			found.torches++
+		}
+	}
+}
+
+// light burns a torch, reporting whether the player had one.
+func (found *supplies) light() bool {
+	if found.sellsTorches {
+		return true
+	}
+
+	if found.torches == 0 {
+		return false
+	}
+
+	found.torches--
+
+	return true
+}
+
+// stocked lists the items the room's merchant has in stock.
+func stocked(chamber room.Room) []item.Item {
+	market, ok := room.Base(chamber).(room.Market)
+	if !ok {
+		return nil
+	}
+
+	var items []item.Item
+	for _, listing := range market.Merchant().Listings {
+		if listing.Stock > 0 {
+			items = append(items, listing.Item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,159 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	key := item.Item{Name: "Iron key", Type: item.Key}
+	treasury := func(items ...item.Item) room.Room {
+		return room.Factory(room.FactoryInput{Kind: room.KindTreasure, Items: items})
+	}
+	torch := item.Item{Name: "Torch", Type: item.Torch}
+	cellar := func(items ...item.Item) room.Room {
+		return room.Factory(room.FactoryInput{Kind: room.KindTreasure, Items: items, Scene: scene.Scene{Lighting: scene.Dark}})
+	}
+	store := func(sold ...item.Item) room.Room {
+		merchant := &shop.Merchant{}
+		for _, listed := range sold {
+			merchant.Listings = append(merchant.Listings, &shop.Listing{Item: listed, Stock: 1})
+		}
+
+		return room.Factory(room.FactoryInput{Kind: room.KindShop, Merchant: merchant})
+	}
+	locked := map[int]game.Door{2: {Seal: game.SealKey, Key: "Iron key"}}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when every door opens before it is reached", func(t *testing.T) {
+			state := &game.State{
+				Rooms: []room.Room{
+					treasury(key),
+					room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &riddle}),
+					room.Factory(room.FactoryInput{Kind: room.KindBoss, Boss: enemy.NewBoss(enemy.Dragon)}),
+					treasury(),
+				},
+				Doors: map[int]game.Door{
+					1: {Seal: game.SealKey, Key: "Iron key"},
+					2: {Seal: game.SealPuzzle, Room: 1},
+					3: {Seal: game.SealBoss, Room: 2},
+				},
+			}
+
+			require.NoError(t, state.Validate())
+		})
+
+		t.Run("when the player starts with the key", func(t *testing.T) {
+			carrier := player.New("Elmster")
+			carrier.Collect(key)
+			state := &game.State{Player: carrier, Rooms: []room.Room{treasury(), treasury(), treasury()}, Doors: locked}
+
+			require.NoError(t, state.Validate())
+		})
+
+		t.Run("when a merchant sells the key", func(t *testing.T) {
+			state := &game.State{Rooms: []room.Room{treasury(), store(key), treasury()}, Doors: locked}
+
+			require.NoError(t, state.Validate())
+		})
+
+		t.Run("when the key lies in the dark and a torch is found first", func(t *testing.T) {
+			state := &game.State{Rooms: []room.Room{treasury(torch), cellar(key), treasury()}, Doors: locked}
+
+			require.NoError(t, state.Validate())
+		})
+
+		t.Run("when a merchant sells torches to find the keys in the dark", func(t *testing.T) {
+			state := &game.State{
+				Rooms: []room.Room{store(torch), cellar(item.Item{Name: "Copper key", Type: item.Key}), cellar(key), treasury()},
+				Doors: map[int]game.Door{2: {Seal: game.SealKey, Key: "Copper key"}, 3: {Seal: game.SealKey, Key: "Iron key"}},
+			}
+
+			require.NoError(t, state.Validate())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when a key is locked behind its own door", func(t *testing.T) {
+			state := &game.State{
+				Rooms: []room.Room{treasury(), treasury(key)},
+				Doors: map[int]game.Door{1: {Seal: game.SealKey, Key: "Iron key"}},
+			}
+
+			require.EqualError(t, state.Validate(), "door 1: Iron key is locked behind its own door")
+		})
+
+		t.Run("when the key lies in the dark with no torch to light it", func(t *testing.T) {
+			state := &game.State{Rooms: []room.Room{treasury(), cellar(key), treasury()}, Doors: locked}
+
+			require.EqualError(t, state.Validate(), "door 2: Iron key lies in the dark with no torch to light it")
+		})
+
+		t.Run("when the only torch burns out before the key is found", func(t *testing.T) {
+			state := &game.State{
+				Rooms: []room.Room{treasury(torch), cellar(item.Item{Name: "Copper key", Type: item.Key}), cellar(key), treasury()},
+				Doors: map[int]game.Door{3: {Seal: game.SealKey, Key: "Iron key"}},
+			}
+
+			require.EqualError(t, state.Validate(), "door 3: Iron key lies in the dark with no torch to light it")
+		})
+
+		t.Run("when every door is wrong", func(t *testing.T) {
+			state := &game.State{
+				Rooms: []room.Room{
+					treasury(),
+					room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &riddle}),
+					treasury(),
+					treasury(),
+					treasury(),
+				},
+				Doors: map[int]game.Door{
+					0: {Seal: game.SealKey, Key: "Iron key"},
+					1: {Seal: game.SealPuzzle, Room: 1},
+					2: {Seal: game.SealBoss, Room: 0},
+					3: {Seal: "charm"},
+					7: {Seal: game.SealKey, Key: "Iron key"},
+				},
+			}
+
+			require.EqualError(t, state.Validate(), "door 0: Iron key is nowhere in the dungeon\n"+
+				"door 1: opens from room 1, behind it\n"+
+				"door 2: room 0 hosts no boss\n"+
+				"door 3: invalid seal\n"+
+				"door 7: leads nowhere")
+		})
+	})
+}
+
+func TestReachable(t *testing.T) {
+	key := item.Item{Name: "Iron key", Type: item.Key}
+	treasury := func(items ...item.Item) room.Room {
+		return room.Factory(room.FactoryInput{Kind: room.KindTreasure, Items: items})
+	}
+
+	t.Run("reaches every room when each door opens before it is reached", func(t *testing.T) {
+		state := &game.State{
+			Rooms: []room.Room{treasury(key), treasury(), treasury()},
+			Doors: map[int]game.Door{2: {Seal: game.SealKey, Key: "Iron key"}},
+		}
+
+		require.Equal(t, 3, state.Reachable())
+	})
+
+	t.Run("stops at the first door that never opens", func(t *testing.T) {
+		state := &game.State{
+			Rooms: []room.Room{treasury(), treasury(), treasury(key), treasury()},
+			Doors: map[int]game.Door{1: {Seal: game.SealBoss, Room: 0}, 2: {Seal: game.SealKey, Key: "Iron key"}},
+		}
+
+		require.Equal(t, 1, state.Reachable())
+	})
+}
@@ -0,0 +1,175 @@
+package leaderboard
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+)
+
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatCSV   Format = "csv"
+	FormatJSON  Format = "json"
+)
+
+type Entry struct {
+	Rank         int            `json:"rank"`
+	Player       string         `json:"player"`
+	Kills        map[string]int `json:"kills"`
+	TotalKills   int            `json:"total_kills"`
+	DamageDealt  int            `json:"damage_dealt"`
+	DamageTaken  int            `json:"damage_taken"`
+	RoomsCleared int            `json:"rooms_cleared"`
+	Deaths       int            `json:"deaths"`
+	FastestClear time.Duration  `json:"fastest_clear"`
+}
+
+// Rank orders players by kills, then rooms cleared, then fewest deaths, then
+// fastest clear, falling back to the player name to keep the order stable.
+func Rank(statistics map[string]observer.PlayerStatistics) []Entry {
+	entries := make([]Entry, 0, len(statistics))
+	for player, playerStatistics := range statistics {
+		entries = append(entries, Entry{
+			Player:       player,
+			Kills:        playerStatistics.Kills,
+			TotalKills:   playerStatistics.TotalKills(),
+			DamageDealt:  playerStatistics.DamageDealt,
+			DamageTaken:  playerStatistics.DamageTaken,
+			RoomsCleared: playerStatistics.RoomsCleared,
+			Deaths:       playerStatistics.Deaths,
+			FastestClear: playerStatistics.FastestClear,
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalKills, a.TotalKills),
+			cmp.Compare(b.RoomsCleared, a.RoomsCleared),
+			cmp.Compare(a.Deaths, b.Deaths),
+			compareClear(a.FastestClear, b.FastestClear),
+			cmp.Compare(a.Player, b.Player),
+		)
+	})
+
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+
+	return entries
+}
+
+// compareClear puts players who never cleared a dungeon after those who did.
+func compareClear(a, b time.Duration) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 1
+	case b == 0:
+		return -1
+	default:
+		return cmp.Compare(a, b)
+	}
+}
+
+func Export(writer io.Writer, entries []Entry, format Format) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(entries)
+	case FormatCSV:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.WriteAll(rows(entries)); err != nil {
+			return err
+		}
+
+		return csvWriter.Error()
+	case FormatTable:
+		tableWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+		for _, row := range rows(entries) {
+			for _, column := range row {
+				if _, err := fmt.Fprintf(tableWriter, "%s\t", column); err != nil {
+					return err
+				}
+			}
+
+			if _, err := fmt.Fprintln(tableWriter); err != nil {
+				return err
+			}
+		}
+
+		return tableWriter.Flush()
+	default:
+		return errors.New("invalid leaderboard format")
+	}
+}
+
+func rows(entries []Entry) [][]string {
+	kinds := killed(entries)
+	header := []string{"rank", "player", "total_kills"}
+	for _, kind := range kinds {
+		header = append(header, "kills_"+string(kind))
+	}
+	header = append(header, "damage_dealt", "damage_taken", "rooms_cleared", "deaths", "fastest_clear")
+
+	rows := [][]string{header}
+	for _, entry := range entries {
+		row := []string{strconv.Itoa(entry.Rank), entry.Player, strconv.Itoa(entry.TotalKills)}
+		for _, kind := range kinds {
+			row = append(row, strconv.Itoa(entry.Kills[kind]))
+		}
+
+		fastestClear := ""
+		if entry.FastestClear > 0 {
+			fastestClear = entry.FastestClear.String()
+		}
+
+		row = append(
+			row,
+			strconv.Itoa(entry.DamageDealt),
+			strconv.Itoa(entry.DamageTaken),
+			strconv.Itoa(entry.RoomsCleared),
+			strconv.Itoa(entry.Deaths),
+			fastestClear,
+		)
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// killed lists the built-in enemy kinds followed by any other kinds the
+// entries killed, such as those from content packs, so every kill has a
+// column without having to load the packs.
+func killed(entries []Entry) []string {
+	var kinds []string
+	for _, kind := range enemy.Kinds() {
+		kinds = append(kinds, string(kind))
+	}
+
+	others := map[string]bool{}
+	for _, entry := range entries {
+		for kind := range entry.Kills {
+			if !slices.Contains(kinds, kind) {
+				others[kind] = true
+			}
+		}
+	}
+
+	return append(kinds, slices.Sorted(maps.Keys(others))...)
+}
@@ -0,0 +1,118 @@
+package leaderboard_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/leaderboard"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+var statistics = map[string]observer.PlayerStatistics{
+	"Elmster": {
+		Kills:        map[string]int{"Goblin": 2, "Troll": 1},
+		DamageDealt:  300,
+		DamageTaken:  40,
+		RoomsCleared: 3,
+		Deaths:       1,
+		FastestClear: 2 * time.Minute,
+	},
+	"Drizzt": {
+		Kills:        map[string]int{"Orc": 3},
+		RoomsCleared: 3,
+		Deaths:       1,
+		FastestClear: time.Minute,
+	},
+	"Minsc": {
+		Deaths: 4,
+	},
+	"Boo": {
+		Deaths: 4,
+	},
+}
+
+func TestRank(t *testing.T) {
+	t.Run("ranks players by their statistics", func(t *testing.T) {
+		actual := leaderboard.Rank(statistics)
+
+		players := make([]string, 0, len(actual))
+		for i, entry := range actual {
+			require.Equal(t, i+1, entry.Rank)
+			players = append(players, entry.Player)
+		}
+
+		require.Equal(t, []string{"Drizzt", "Elmster", "Boo", "Minsc"}, players)
+	})
+
+	t.Run("puts players who never cleared a dungeon last", func(t *testing.T) {
+		actual := leaderboard.Rank(map[string]observer.PlayerStatistics{
+			"Elmster": {},
+			"Drizzt":  {FastestClear: time.Hour},
+		})
+
+		require.Equal(t, "Drizzt", actual[0].Player)
+		require.Equal(t, "Elmster", actual[1].Player)
+	})
+}
+
+func TestExport(t *testing.T) {
+	entries := leaderboard.Rank(statistics)
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when exporting as csv", func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			require.NoError(t, leaderboard.Export(buffer, entries[:2], leaderboard.FormatCSV))
+			require.Equal(
+				t,
+				"rank,player,total_kills,kills_Goblin,kills_Orc,kills_Troll,kills_Dragon,damage_dealt,damage_taken,rooms_cleared,deaths,fastest_clear\n"+
+					"1,Drizzt,3,0,3,0,0,0,0,3,1,1m0s\n"+
+					"2,Elmster,3,2,0,1,0,300,40,3,1,2m0s\n",
+				buffer.String(),
+			)
+		})
+
+		t.Run("when players killed enemies that are not built in", func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			ghoulish := leaderboard.Rank(map[string]observer.PlayerStatistics{
+				"Jaheira": {Kills: map[string]int{"Skeleton": 2, "Goblin": 1, "Ghoul": 1}},
+			})
+
+			require.NoError(t, leaderboard.Export(buffer, ghoulish, leaderboard.FormatCSV))
+			require.Equal(
+				t,
+				"rank,player,total_kills,kills_Goblin,kills_Orc,kills_Troll,kills_Dragon,kills_Ghoul,kills_Skeleton,damage_dealt,damage_taken,rooms_cleared,deaths,fastest_clear\n"+
+					"1,Jaheira,4,1,0,0,0,1,2,0,0,0,0,\n",
+				buffer.String(),
+			)
+		})
+
+		t.Run("when exporting as json", func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			require.NoError(t, leaderboard.Export(buffer, entries, leaderboard.FormatJSON))
+
+			var actual []leaderboard.Entry
+			require.NoError(t, json.Unmarshal(buffer.Bytes(), &actual))
+			require.Equal(t, entries, actual)
+		})
+
+		t.Run("when exporting as a table", func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			require.NoError(t, leaderboard.Export(buffer, entries[3:], leaderboard.FormatTable))
+			require.Contains(t, buffer.String(), "4     Minsc")
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when format is unknown", func(t *testing.T) {
+			err := leaderboard.Export(&bytes.Buffer{}, entries, "xml")
+
+			require.EqualError(t, err, "invalid leaderboard format")
+		})
+	})
+}
@@ -4,20 +4,84 @@ import (
 	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
 	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
 	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
 )
 
 type FactoryInput struct {
-	Kind    Kind
-	Items   []item.Item
-	Enemies []*enemy.Enemy
+	Kind      Kind
+	Items     []item.Item
+	Enemies   []*enemy.Enemy
+	Boss      *enemy.Boss
+	Puzzle    *puzzle.Definition
+	Hazards   []trap.Hazard
+	Merchant  *shop.Merchant
+	Sanctuary *rest.Sanctuary
+	Scene     scene.Scene
+}
+
+// describer is a room whose scene can be set once it is built.
+type describer interface {
+	Describe(scene.Scene)
 }
 
 func Factory(input FactoryInput) Room {
+	built := build(input)
+	if built == nil {
+		return nil
+	}
+
+	if described, ok := built.(describer); ok {
+		described.Describe(input.Scene)
+	}
+
+	return built
+}
+
+func build(input FactoryInput) Room {
 	switch input.Kind {
 	case KindTreasure:
 		return internal.NewTreasureRoom(input.Items)
 	case KindEnemy:
 		return internal.NewEnemyRoom(input.Items, input.Enemies)
+	case KindBoss:
+		if input.Boss == nil || len(input.Enemies) > 0 {
+			return nil
+		}
+
+		return internal.NewBossRoom(input.Items, input.Boss)
+	case KindPuzzle:
+		if input.Puzzle == nil {
+			return nil
+		}
+
+		posed, err := input.Puzzle.Build()
+		if err != nil {
+			return nil
+		}
+
+		return internal.NewPuzzleRoom(input.Items, *input.Puzzle, posed)
+	case KindTrap:
+		if len(input.Hazards) == 0 {
+			return nil
+		}
+
+		return internal.NewTrapRoom(input.Items, input.Hazards)
+	case KindShop:
+		if input.Merchant == nil {
+			return nil
+		}
+
+		return internal.NewShopRoom(input.Items, input.Merchant)
+	case KindRest:
+		if input.Sanctuary == nil {
+			return nil
+		}
+
+		return internal.NewRestRoom(input.Items, *input.Sanctuary)
 	default:
 		return nil
 	}
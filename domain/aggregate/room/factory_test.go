@@ -7,13 +7,18 @@ import (
 	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
 	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
 	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
 	"github.com/stretchr/testify/require"
 )
 
 func TestRoomFactory(t *testing.T) {
 	t.Run("fails to construct when kind is invalid", func(t *testing.T) {
 		items := []item.Item{}
-		enemies := []enemy.Enemy{}
+		enemies := []*enemy.Enemy{}
 		kind := room.Kind("invalid")
 
 		actual := room.Factory(room.FactoryInput{
@@ -27,7 +32,7 @@ func TestRoomFactory(t *testing.T) {
 
 	t.Run("constructs a treasure room", func(t *testing.T) {
 		items := []item.Item{}
-		enemies := []enemy.Enemy{}
+		enemies := []*enemy.Enemy{}
 		kind := room.KindTreasure
 
 		actual := room.Factory(room.FactoryInput{
@@ -44,7 +49,7 @@ func TestRoomFactory(t *testing.T) {
 
 	t.Run("constructs an enemy room", func(t *testing.T) {
 		items := []item.Item{}
-		enemies := []enemy.Enemy{}
+		enemies := []*enemy.Enemy{}
 		kind := room.KindEnemy
 
 		actual := room.Factory(room.FactoryInput{
@@ -58,4 +63,122 @@ func TestRoomFactory(t *testing.T) {
 		require.NotNil(t, actual)
 		require.Equal(t, expected, actual)
 	})
+
+	t.Run("constructs a boss room", func(t *testing.T) {
+		items := []item.Item{}
+		boss := enemy.NewBoss(enemy.Dragon)
+
+		actual := room.Factory(room.FactoryInput{
+			Kind:  room.KindBoss,
+			Items: items,
+			Boss:  boss,
+		})
+
+		expected := internal.NewBossRoom(items, boss)
+
+		require.NotNil(t, actual)
+		require.Equal(t, expected, actual)
+		require.True(t, actual.Locked())
+	})
+
+	t.Run("fails to construct a boss room without exactly one boss", func(t *testing.T) {
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindBoss}))
+		require.Nil(t, room.Factory(room.FactoryInput{
+			Kind:    room.KindBoss,
+			Boss:    enemy.NewBoss(enemy.Dragon),
+			Enemies: []*enemy.Enemy{enemy.New(enemy.Goblin)},
+		}))
+	})
+}
+
+func TestRoomFactoryPuzzle(t *testing.T) {
+	t.Run("constructs a puzzle room", func(t *testing.T) {
+		definition := &puzzle.Definition{Name: "Vault", Kind: puzzle.KindCombination, Code: "427", Attempts: 3}
+
+		actual := room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: definition})
+
+		expected := internal.NewPuzzleRoom(nil, *definition, puzzle.Combination{Code: "427"})
+
+		require.NotNil(t, actual)
+		require.Equal(t, expected, actual)
+		require.Equal(t, "Vault", actual.(room.Enigma).Name())
+	})
+
+	t.Run("fails to construct a puzzle room", func(t *testing.T) {
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindPuzzle}))
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindPuzzle, Puzzle: &puzzle.Definition{Kind: "maze"}}))
+	})
+}
+
+func TestRoomFactoryTrap(t *testing.T) {
+	t.Run("constructs a trap room", func(t *testing.T) {
+		hazards := []trap.Hazard{trap.SpikePit}
+
+		actual := room.Factory(room.FactoryInput{Kind: room.KindTrap, Hazards: hazards})
+
+		require.Equal(t, internal.NewTrapRoom(nil, hazards), actual)
+		require.Len(t, actual.(room.Snare).Traps(trap.TriggerEntry), 1)
+	})
+
+	t.Run("fails to construct a trap room without hazards", func(t *testing.T) {
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindTrap}))
+	})
+}
+
+func TestRoomFactoryShop(t *testing.T) {
+	t.Run("constructs a shop room", func(t *testing.T) {
+		merchant := shop.GeneralStore()
+
+		actual := room.Factory(room.FactoryInput{Kind: room.KindShop, Merchant: merchant})
+
+		require.Equal(t, internal.NewShopRoom(nil, merchant), actual)
+		require.Same(t, merchant, actual.(room.Market).Merchant())
+	})
+
+	t.Run("fails to construct a shop room without a merchant", func(t *testing.T) {
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindShop}))
+	})
+}
+
+func TestRoomFactoryRest(t *testing.T) {
+	t.Run("constructs a rest room", func(t *testing.T) {
+		sanctuary := &rest.Sanctuary{Turns: 3, Heal: 10}
+
+		actual := room.Factory(room.FactoryInput{Kind: room.KindRest, Sanctuary: sanctuary})
+
+		require.Equal(t, internal.NewRestRoom(nil, *sanctuary), actual)
+		require.Equal(t, *sanctuary, actual.(room.Haven).Sanctuary())
+	})
+
+	t.Run("fails to construct a rest room without a sanctuary", func(t *testing.T) {
+		require.Nil(t, room.Factory(room.FactoryInput{Kind: room.KindRest}))
+	})
+}
+
+func TestRoomFactoryScene(t *testing.T) {
+	t.Run("describes the room", func(t *testing.T) {
+		crypt := scene.Scene{Name: "Crypt", Description: "Cold stone and colder air.", Features: []string{"a cracked sarcophagus"}, Lighting: scene.Dark}
+
+		actual := room.Factory(room.FactoryInput{Kind: room.KindTreasure, Scene: crypt})
+
+		require.Equal(t, crypt, actual.Scene())
+		require.True(t, actual.Dark())
+	})
+
+	t.Run("leaves rooms without a scene bright", func(t *testing.T) {
+		actual := room.Factory(room.FactoryInput{Kind: room.KindTreasure})
+
+		require.Equal(t, scene.Bright, actual.Scene().OrBright())
+		require.False(t, actual.Dark())
+	})
+}
+
+func TestKindLoot(t *testing.T) {
+	t.Run("bosses always drop something", func(t *testing.T) {
+		require.NotEmpty(t, room.KindBoss.Loot().Guaranteed)
+	})
+
+	t.Run("treasure rooms drop nothing extra", func(t *testing.T) {
+		require.Empty(t, room.KindTreasure.Loot().Roll(nil))
+	})
 }
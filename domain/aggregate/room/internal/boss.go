@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// BossRoom hosts a single boss and keeps its exits locked until the boss is
+// defeated.
+type BossRoom struct {
+	Scenery
+
+	items []item.Item
+	boss  *enemy.Boss
+}
+
+func NewBossRoom(items []item.Item, boss *enemy.Boss) *BossRoom {
+	return &BossRoom{items: items, boss: boss}
+}
+
+func (b *BossRoom) Items() []item.Item {
+	return b.items
+}
+
+func (b *BossRoom) Enemies() []*enemy.Enemy {
+	return []*enemy.Enemy{b.boss.Enemy}
+}
+
+func (b *BossRoom) Boss() *enemy.Boss {
+	return b.boss
+}
+
+func (b *BossRoom) Locked() bool {
+	return b.boss.Health() > 0
+}
+
+func (b *BossRoom) Drop(items ...item.Item) {
+	b.items = append(b.items, items...)
+}
+
+func (b *BossRoom) Take() []item.Item {
+	taken := b.items
+	b.items = nil
+
+	return taken
+}
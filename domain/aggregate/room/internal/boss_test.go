@@ -0,0 +1,34 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBossRoom(t *testing.T) {
+	t.Run("constructs a boss room", func(t *testing.T) {
+		items := []item.Item{}
+		boss := enemy.NewBoss(enemy.Dragon)
+		actual := internal.NewBossRoom(items, boss)
+
+		require.NotNil(t, actual)
+		require.Equal(t, items, actual.Items())
+		require.Equal(t, []*enemy.Enemy{boss.Enemy}, actual.Enemies())
+		require.Same(t, boss, actual.Boss())
+	})
+
+	t.Run("stays locked until the boss is defeated", func(t *testing.T) {
+		boss := enemy.NewBoss(enemy.Dragon)
+		actual := internal.NewBossRoom(nil, boss)
+
+		require.True(t, actual.Locked())
+
+		boss.Life.Value = 0
+
+		require.False(t, actual.Locked())
+	})
+}
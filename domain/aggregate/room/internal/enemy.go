@@ -6,6 +6,8 @@ import (
 )
 
 type EnemyRoom struct {
+	Scenery
+
 	items   []item.Item
 	enemies []*enemy.Enemy
 }
@@ -21,3 +23,18 @@ func (e *EnemyRoom) Items() []item.Item {
 func (e *EnemyRoom) Enemies() []*enemy.Enemy {
 	return e.enemies
 }
+
+func (e *EnemyRoom) Locked() bool {
+	return false
+}
+
+func (e *EnemyRoom) Drop(items ...item.Item) {
+	e.items = append(e.items, items...)
+}
+
+func (e *EnemyRoom) Take() []item.Item {
+	taken := e.items
+	e.items = nil
+
+	return taken
+}
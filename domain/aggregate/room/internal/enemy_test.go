@@ -12,7 +12,7 @@ import (
 func TestNewEnemyRoom(t *testing.T) {
 	t.Run("constructs an enemy room", func(t *testing.T) {
 		items := []item.Item{}
-		enemies := []enemy.Enemy{}
+		enemies := []*enemy.Enemy{}
 		actual := internal.NewEnemyRoom(items, enemies)
 		expected := internal.NewEnemyRoom(items, enemies)
 
@@ -23,3 +23,18 @@ func TestNewEnemyRoom(t *testing.T) {
 		require.Equal(t, expected.Enemies(), actual.Enemies())
 	})
 }
+
+func TestEnemyRoomDrop(t *testing.T) {
+	t.Run("leaves dropped items in the room", func(t *testing.T) {
+		potion := item.Item{Name: "Potion", Type: item.Potion}
+		dagger := item.Item{Name: "Dagger", Type: item.Weapon}
+		actual := internal.NewEnemyRoom([]item.Item{potion}, nil)
+
+		actual.Drop(dagger)
+
+		require.Equal(t, []item.Item{potion, dagger}, actual.Items())
+		require.False(t, actual.Locked())
+		require.Equal(t, []item.Item{potion, dagger}, actual.Take())
+		require.Empty(t, actual.Items())
+	})
+}
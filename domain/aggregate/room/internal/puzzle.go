@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"errors"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+)
+
+// PuzzleRoom poses a puzzle with a limited number of attempts. Solving it
+// leaves the reward in the room, and the exits stay locked until it is solved
+// or every attempt is spent.
+type PuzzleRoom struct {
+	Scenery
+
+	items      []item.Item
+	definition puzzle.Definition
+	puzzle     puzzle.Puzzle
+	attempts   int
+	solved     bool
+}
+
+func NewPuzzleRoom(items []item.Item, definition puzzle.Definition, posed puzzle.Puzzle) *PuzzleRoom {
+	return &PuzzleRoom{items: items, definition: definition, puzzle: posed}
+}
+
+func (p *PuzzleRoom) Items() []item.Item {
+	return p.items
+}
+
+func (p *PuzzleRoom) Enemies() []*enemy.Enemy {
+	return make([]*enemy.Enemy, 0)
+}
+
+func (p *PuzzleRoom) Locked() bool {
+	return !p.solved && p.attempts < p.definition.Attempts
+}
+
+func (p *PuzzleRoom) Drop(items ...item.Item) {
+	p.items = append(p.items, items...)
+}
+
+func (p *PuzzleRoom) Take() []item.Item {
+	taken := p.items
+	p.items = nil
+
+	return taken
+}
+
+func (p *PuzzleRoom) Puzzle() puzzle.Puzzle {
+	return p.puzzle
+}
+
+// Solved reports whether the puzzle was solved, as opposed to given up on.
+func (p *PuzzleRoom) Solved() bool {
+	return p.solved
+}
+
+// Attempts is how many attempts were spent on the puzzle.
+func (p *PuzzleRoom) Attempts() int {
+	return p.attempts
+}
+
+// Rewind puts the puzzle back as it was after the attempts, leaving the items
+// in the room alone, such as when the game is rolled back to a checkpoint.
+func (p *PuzzleRoom) Rewind(attempts int, solved bool) {
+	p.attempts, p.solved = attempts, solved
+}
+
+func (p *PuzzleRoom) Name() string {
+	return p.definition.Name
+}
+
+// Attempt answers the puzzle, spending one attempt.
+func (p *PuzzleRoom) Attempt(answer string) (puzzle.Result, error) {
+	if p.solved {
+		return puzzle.Result{}, errors.New("puzzle already solved")
+	}
+
+	if p.attempts >= p.definition.Attempts {
+		return puzzle.Result{}, errors.New("no attempts left")
+	}
+
+	p.attempts++
+	result := puzzle.Result{Remaining: p.definition.Attempts - p.attempts}
+
+	if p.puzzle.Solve(answer) {
+		p.solved = true
+		p.Drop(p.definition.Reward...)
+		result.Solved = true
+
+		return result, nil
+	}
+
+	result.Penalty = p.definition.Penalty
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPuzzleRoom(t *testing.T) {
+	amulet := item.Item{Name: "Amulet", Type: item.Armour, Rarity: item.Rare}
+	definition := puzzle.Definition{
+		Name:     "Sphinx",
+		Kind:     puzzle.KindRiddle,
+		Prompt:   "What has keys but opens no locks?",
+		Answers:  []string{"piano"},
+		Attempts: 2,
+		Penalty:  10,
+		Reward:   []item.Item{amulet},
+	}
+	newRoom := func() *internal.PuzzleRoom {
+		posed, err := definition.Build()
+		require.NoError(t, err)
+
+		return internal.NewPuzzleRoom(nil, definition, posed)
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the answer is right", func(t *testing.T) {
+			actual := newRoom()
+			require.True(t, actual.Locked())
+			require.Empty(t, actual.Items())
+
+			result, err := actual.Attempt("Piano")
+
+			require.NoError(t, err)
+			require.Equal(t, puzzle.Result{Solved: true, Remaining: 1}, result)
+			require.Equal(t, []item.Item{amulet}, actual.Items(), "leaves the reward")
+			require.False(t, actual.Locked())
+			require.True(t, actual.Solved())
+		})
+
+		t.Run("when the answer is wrong", func(t *testing.T) {
+			actual := newRoom()
+
+			result, err := actual.Attempt("door")
+
+			require.NoError(t, err)
+			require.Equal(t, puzzle.Result{Remaining: 1, Penalty: 10}, result)
+			require.True(t, actual.Locked())
+			require.False(t, actual.Solved())
+			require.Empty(t, actual.Items())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the puzzle is already solved", func(t *testing.T) {
+			actual := newRoom()
+			_, _ = actual.Attempt("piano")
+
+			_, err := actual.Attempt("piano")
+
+			require.EqualError(t, err, "puzzle already solved")
+		})
+
+		t.Run("when every attempt is spent", func(t *testing.T) {
+			actual := newRoom()
+			_, _ = actual.Attempt("door")
+			_, _ = actual.Attempt("window")
+
+			_, err := actual.Attempt("piano")
+
+			require.EqualError(t, err, "no attempts left")
+			require.False(t, actual.Locked(), "opens once the puzzle is lost")
+		})
+	})
+}
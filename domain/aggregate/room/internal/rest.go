@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+)
+
+// RestRoom is a sanctuary where the player can recover life.
+type RestRoom struct {
+	Scenery
+
+	items     []item.Item
+	sanctuary rest.Sanctuary
+}
+
+func NewRestRoom(items []item.Item, sanctuary rest.Sanctuary) *RestRoom {
+	return &RestRoom{items: items, sanctuary: sanctuary}
+}
+
+func (r *RestRoom) Items() []item.Item {
+	return r.items
+}
+
+func (r *RestRoom) Enemies() []*enemy.Enemy {
+	return make([]*enemy.Enemy, 0)
+}
+
+func (r *RestRoom) Locked() bool {
+	return false
+}
+
+func (r *RestRoom) Drop(items ...item.Item) {
+	r.items = append(r.items, items...)
+}
+
+func (r *RestRoom) Take() []item.Item {
+	taken := r.items
+	r.items = nil
+
+	return taken
+}
+
+func (r *RestRoom) Sanctuary() rest.Sanctuary {
+	return r.sanctuary
+}
@@ -0,0 +1,24 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRestRoom(t *testing.T) {
+	t.Run("constructs a rest room", func(t *testing.T) {
+		sanctuary := rest.Sanctuary{Turns: 3, Heal: 10}
+		potion := item.Item{Name: "Potion", Type: item.Potion}
+		actual := internal.NewRestRoom([]item.Item{potion}, sanctuary)
+
+		require.Equal(t, sanctuary, actual.Sanctuary())
+		require.Empty(t, actual.Enemies())
+		require.False(t, actual.Locked())
+		require.Equal(t, []item.Item{potion}, actual.Take())
+		require.Empty(t, actual.Items(), "taken items leave the room")
+	})
+}
@@ -0,0 +1,29 @@
+package internal
+
+import "github.com/pedrokunz/go-design-patterns/domain/core/scene"
+
+// Scenery is what a room looks like. Rooms embed it so they all describe
+// themselves the same way.
+type Scenery struct {
+	scene scene.Scene
+	lit   bool
+}
+
+func (s *Scenery) Scene() scene.Scene {
+	return s.scene
+}
+
+// Describe sets what the room looks like.
+func (s *Scenery) Describe(described scene.Scene) {
+	s.scene = described
+}
+
+// Dark reports whether the room is too dark to see what lies in it.
+func (s *Scenery) Dark() bool {
+	return s.scene.OrBright() == scene.Dark && !s.lit
+}
+
+// Light lights the room up for good, such as with a torch.
+func (s *Scenery) Light() {
+	s.lit = true
+}
@@ -0,0 +1,29 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenery(t *testing.T) {
+	t.Run("stays dark until lit", func(t *testing.T) {
+		actual := internal.NewTreasureRoom(nil)
+		actual.Describe(scene.Scene{Name: "Cellar", Lighting: scene.Dark})
+		require.True(t, actual.Dark())
+
+		actual.Light()
+
+		require.False(t, actual.Dark())
+		require.Equal(t, "Cellar", actual.Scene().Name)
+	})
+
+	t.Run("is never dark when dimly lit", func(t *testing.T) {
+		actual := internal.NewEnemyRoom(nil, nil)
+		actual.Describe(scene.Scene{Name: "Hall", Lighting: scene.Dim})
+
+		require.False(t, actual.Dark())
+	})
+}
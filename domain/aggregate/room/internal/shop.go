@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+)
+
+// ShopRoom is where a merchant trades with the player.
+type ShopRoom struct {
+	Scenery
+
+	items    []item.Item
+	merchant *shop.Merchant
+}
+
+func NewShopRoom(items []item.Item, merchant *shop.Merchant) *ShopRoom {
+	return &ShopRoom{items: items, merchant: merchant}
+}
+
+func (s *ShopRoom) Items() []item.Item {
+	return s.items
+}
+
+func (s *ShopRoom) Enemies() []*enemy.Enemy {
+	return make([]*enemy.Enemy, 0)
+}
+
+func (s *ShopRoom) Locked() bool {
+	return false
+}
+
+func (s *ShopRoom) Drop(items ...item.Item) {
+	s.items = append(s.items, items...)
+}
+
+func (s *ShopRoom) Take() []item.Item {
+	taken := s.items
+	s.items = nil
+
+	return taken
+}
+
+func (s *ShopRoom) Merchant() *shop.Merchant {
+	return s.merchant
+}
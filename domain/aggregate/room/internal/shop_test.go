@@ -0,0 +1,20 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShopRoom(t *testing.T) {
+	t.Run("constructs a shop room", func(t *testing.T) {
+		merchant := shop.GeneralStore()
+		actual := internal.NewShopRoom(nil, merchant)
+
+		require.Same(t, merchant, actual.Merchant())
+		require.Empty(t, actual.Enemies())
+		require.False(t, actual.Locked())
+	})
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+)
+
+// TrapRoom hides traps that go off on entry or when its items are picked up.
+type TrapRoom struct {
+	Scenery
+
+	items []item.Item
+	traps []*trap.Trap
+}
+
+func NewTrapRoom(items []item.Item, hazards []trap.Hazard) *TrapRoom {
+	traps := make([]*trap.Trap, 0, len(hazards))
+	for _, hazard := range hazards {
+		traps = append(traps, trap.New(hazard))
+	}
+
+	return &TrapRoom{items: items, traps: traps}
+}
+
+func (t *TrapRoom) Items() []item.Item {
+	return t.items
+}
+
+func (t *TrapRoom) Enemies() []*enemy.Enemy {
+	return make([]*enemy.Enemy, 0)
+}
+
+func (t *TrapRoom) Locked() bool {
+	return false
+}
+
+func (t *TrapRoom) Drop(items ...item.Item) {
+	t.items = append(t.items, items...)
+}
+
+func (t *TrapRoom) Take() []item.Item {
+	taken := t.items
+	t.items = nil
+
+	return taken
+}
+
+// Traps lists the traps still armed for the trigger.
+func (t *TrapRoom) Traps(trigger trap.Trigger) []*trap.Trap {
+	armed := make([]*trap.Trap, 0)
+	for _, set := range t.traps {
+		if set.Trigger == trigger && set.Armed() {
+			armed = append(armed, set)
+		}
+	}
+
+	return armed
+}
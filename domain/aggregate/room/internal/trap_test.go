@@ -0,0 +1,26 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrapRoom(t *testing.T) {
+	t.Run("lists the traps armed for a trigger", func(t *testing.T) {
+		actual := internal.NewTrapRoom(nil, []trap.Hazard{trap.SpikePit, trap.PoisonDart, trap.CollapsingFloor})
+
+		entry := actual.Traps(trap.TriggerEntry)
+		require.Len(t, entry, 2)
+		require.Equal(t, "Spike pit", entry[0].Name)
+		require.Len(t, actual.Traps(trap.TriggerLoot), 1)
+
+		entry[0].Disarmed = true
+		entry[1].Sprung = true
+
+		require.Empty(t, actual.Traps(trap.TriggerEntry))
+		require.False(t, actual.Locked())
+	})
+}
@@ -6,6 +6,8 @@ import (
 )
 
 type TreasureRoom struct {
+	Scenery
+
 	items []item.Item
 }
 
@@ -20,3 +22,18 @@ func (t *TreasureRoom) Items() []item.Item {
 func (t *TreasureRoom) Enemies() []*enemy.Enemy {
 	return make([]*enemy.Enemy, 0)
 }
+
+func (t *TreasureRoom) Locked() bool {
+	return false
+}
+
+func (t *TreasureRoom) Drop(items ...item.Item) {
+	t.items = append(t.items, items...)
+}
+
+func (t *TreasureRoom) Take() []item.Item {
+	taken := t.items
+	t.items = nil
+
+	return taken
+}
@@ -5,4 +5,14 @@ type Kind string
 const (
 	KindTreasure Kind = "Treasure"
 	KindEnemy    Kind = "Enemy"
+	KindBoss     Kind = "Boss"
+	KindPuzzle   Kind = "Puzzle"
+	KindTrap     Kind = "Trap"
+	KindShop     Kind = "Shop"
+	KindRest     Kind = "Rest"
 )
+
+// Kinds lists every room kind the factory builds.
+func Kinds() []Kind {
+	return []Kind{KindTreasure, KindEnemy, KindBoss, KindPuzzle, KindTrap, KindShop, KindRest}
+}
@@ -0,0 +1,36 @@
+package room
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/loot"
+)
+
+var tables = map[Kind]loot.Table{
+	KindEnemy: {
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Potion", Type: item.Potion, Rarity: item.Common}, Weight: 1},
+		},
+		Nothing: 3,
+		Rolls:   1,
+	},
+	KindBoss: {
+		Guaranteed: []item.Item{{Name: "Potion", Type: item.Potion, Rarity: item.Common}},
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Crown of the deep", Type: item.Armour, Rarity: item.Legendary}, Weight: 1},
+		},
+		Nothing: 9,
+		Rolls:   1,
+	},
+}
+
+// RegisterLoot sets what clearing rooms of the kind drops, replacing any loot
+// table the kind had.
+func RegisterLoot(kind Kind, table loot.Table) {
+	tables[kind] = table
+}
+
+// Loot is the table rolled for what clearing a room of this kind drops on top
+// of what its enemies drop.
+func (kind Kind) Loot() loot.Table {
+	return tables[kind]
+}
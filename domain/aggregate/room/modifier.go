@@ -0,0 +1,158 @@
+package room
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+)
+
+// Modifier is something that changes a room, whatever its kind.
+type Modifier string
+
+const (
+	// ModifierCursed halves the healing the player receives.
+	ModifierCursed Modifier = "cursed"
+	// ModifierDark plunges the room into darkness until a torch is lit.
+	ModifierDark Modifier = "dark"
+	// ModifierFlooded halves the player's speed, so foes strike first.
+	ModifierFlooded Modifier = "flooded"
+	// ModifierBlessed sharpens the player's accuracy and critical chance.
+	ModifierBlessed Modifier = "blessed"
+)
+
+// Modifiers lists every modifier Decorate knows.
+func Modifiers() []Modifier {
+	return []Modifier{ModifierCursed, ModifierDark, ModifierFlooded, ModifierBlessed}
+}
+
+// Decorate wraps the room in the modifiers, innermost first. Unknown
+// modifiers are skipped. A decorated room only exposes Room; use Base to
+// reach what else the room underneath can do, such as its boss.
+func Decorate(decorated Room, modifiers ...Modifier) Room {
+	for _, modifier := range modifiers {
+		switch modifier {
+		case ModifierCursed:
+			decorated = Cursed(decorated)
+		case ModifierDark:
+			decorated = Darkened(decorated)
+		case ModifierFlooded:
+			decorated = Flooded(decorated)
+		case ModifierBlessed:
+			decorated = Blessed(decorated)
+		}
+	}
+
+	return decorated
+}
+
+// Base peels every modifier off the room.
+func Base(decorated Room) Room {
+	for {
+		wrapper, ok := decorated.(interface{ Unwrap() Room })
+		if !ok {
+			return decorated
+		}
+
+		decorated = wrapper.Unwrap()
+	}
+}
+
+// decorator forwards everything to the room it wraps, including the changes
+// the modifiers underneath make to the player.
+type decorator struct {
+	Room
+}
+
+func (d decorator) Unwrap() Room {
+	return d.Room
+}
+
+func (d decorator) Adjust(stat player.Stat, value int) int {
+	if inner, ok := d.Room.(player.Surroundings); ok {
+		return inner.Adjust(stat, value)
+	}
+
+	return value
+}
+
+type cursed struct {
+	decorator
+}
+
+// Cursed wraps the room in a curse that halves the healing the player
+// receives there.
+func Cursed(decorated Room) Room {
+	return cursed{decorator{decorated}}
+}
+
+func (c cursed) Adjust(stat player.Stat, value int) int {
+	value = c.decorator.Adjust(stat, value)
+	if stat == player.StatHealing {
+		return value / 2
+	}
+
+	return value
+}
+
+type flooded struct {
+	decorator
+}
+
+// Flooded fills the room with water that halves the player's speed, letting
+// the foes there strike first.
+func Flooded(decorated Room) Room {
+	return flooded{decorator{decorated}}
+}
+
+func (f flooded) Adjust(stat player.Stat, value int) int {
+	value = f.decorator.Adjust(stat, value)
+	if stat == player.StatSpeed {
+		return value / 2
+	}
+
+	return value
+}
+
+type blessed struct {
+	decorator
+}
+
+// Blessed wraps the room in a blessing that raises the player's accuracy and
+// critical chance by ten points.
+func Blessed(decorated Room) Room {
+	return blessed{decorator{decorated}}
+}
+
+func (b blessed) Adjust(stat player.Stat, value int) int {
+	value = b.decorator.Adjust(stat, value)
+	if stat == player.StatAccuracy || stat == player.StatCritChance {
+		return value + 10
+	}
+
+	return value
+}
+
+type darkened struct {
+	decorator
+	lit bool
+}
+
+// Darkened plunges the room into darkness, hiding its items until it is lit.
+func Darkened(decorated Room) Room {
+	return &darkened{decorator: decorator{decorated}}
+}
+
+func (d *darkened) Scene() scene.Scene {
+	described := d.Room.Scene()
+	described.Lighting = scene.Dark
+
+	return described
+}
+
+func (d *darkened) Dark() bool {
+	return !d.lit
+}
+
+func (d *darkened) Light() {
+	d.lit = true
+	d.Room.Light()
+}
@@ -0,0 +1,91 @@
+package room_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecorate(t *testing.T) {
+	potion := item.Item{Name: "Potion", Type: item.Potion}
+	newRoom := func() room.Room {
+		return room.Factory(room.FactoryInput{
+			Kind:    room.KindEnemy,
+			Items:   []item.Item{potion},
+			Enemies: []*enemy.Enemy{enemy.New(enemy.Goblin)},
+			Scene:   scene.Scene{Name: "Cistern"},
+		})
+	}
+
+	t.Run("keeps what the room underneath does", func(t *testing.T) {
+		base := newRoom()
+
+		actual := room.Decorate(base, room.ModifierCursed, room.ModifierFlooded)
+
+		require.Equal(t, []item.Item{potion}, actual.Items())
+		require.Len(t, actual.Enemies(), 1)
+		require.Equal(t, "Cistern", actual.Scene().Name)
+		require.Same(t, base, room.Base(actual))
+	})
+
+	t.Run("stacks the changes to the player", func(t *testing.T) {
+		actual := room.Decorate(newRoom(), room.ModifierCursed, room.ModifierFlooded, room.ModifierBlessed)
+		surroundings := actual.(player.Surroundings)
+
+		require.Equal(t, 10, surroundings.Adjust(player.StatHealing, 20))
+		require.Equal(t, 5, surroundings.Adjust(player.StatSpeed, 10))
+		require.Equal(t, 10, surroundings.Adjust(player.StatEvasion, 10))
+		require.Equal(t, 100, surroundings.Adjust(player.StatAccuracy, 90))
+		require.Equal(t, 15, surroundings.Adjust(player.StatCritChance, 5))
+	})
+
+	t.Run("slows the player down in flooded rooms", func(t *testing.T) {
+		elmster := player.New("Elmster")
+		elmster.Surroundings = room.Decorate(newRoom(), room.ModifierFlooded).(player.Surroundings)
+
+		require.Equal(t, 5, elmster.Initiative())
+		require.Less(t, elmster.Initiative(), enemy.New(enemy.Goblin).Speed)
+	})
+
+	t.Run("curses the healing effects give the player", func(t *testing.T) {
+		elmster := player.New("Elmster")
+		elmster.Life.Value = 50
+		elmster.Surroundings = room.Decorate(newRoom(), room.ModifierCursed).(player.Surroundings)
+		elmster.ApplyEffect(effect.Effect{Kind: effect.Regeneration, Duration: 1, Magnitude: 8, Phase: effect.TurnEnd})
+
+		ticks := elmster.TickEffects(effect.TurnEnd)
+
+		require.Equal(t, 4, ticks[0].Amount)
+		require.Equal(t, 54, elmster.Health())
+	})
+
+	t.Run("curses compound", func(t *testing.T) {
+		actual := room.Decorate(newRoom(), room.ModifierCursed, room.ModifierCursed)
+
+		require.Equal(t, 5, actual.(player.Surroundings).Adjust(player.StatHealing, 20))
+	})
+
+	t.Run("darkens the room until it is lit", func(t *testing.T) {
+		actual := room.Decorate(newRoom(), room.ModifierDark, room.ModifierBlessed)
+		require.True(t, actual.Dark())
+		require.Equal(t, scene.Dark, actual.Scene().Lighting)
+
+		actual.Light()
+
+		require.False(t, actual.Dark())
+	})
+
+	t.Run("skips unknown modifiers", func(t *testing.T) {
+		base := newRoom()
+
+		require.Same(t, base, room.Decorate(base, "haunted"))
+		_, ok := base.(player.Surroundings)
+		require.False(t, ok, "undecorated rooms leave the player alone")
+	})
+}
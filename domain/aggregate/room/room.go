@@ -3,9 +3,64 @@ package room
 import (
 	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
 	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
 )
 
 type Room interface {
 	Items() []item.Item
 	Enemies() []*enemy.Enemy
+	// Locked reports whether the room's exits are shut, such as until its
+	// boss is defeated.
+	Locked() bool
+	// Drop leaves items in the room for the player to pick up.
+	Drop(items ...item.Item)
+	// Take removes every item from the room for the player to carry away.
+	Take() []item.Item
+	// Scene is what the room looks like.
+	Scene() scene.Scene
+	// Dark reports whether the room is too dark to see its items, until it
+	// is lit.
+	Dark() bool
+	// Light lights the room up for good, such as with a torch, so its items
+	// can be seen.
+	Light()
+}
+
+// Lair is a room hosting a boss.
+type Lair interface {
+	Room
+	Boss() *enemy.Boss
+}
+
+// Enigma is a room posing a puzzle.
+type Enigma interface {
+	Room
+	Name() string
+	Puzzle() puzzle.Puzzle
+	Attempt(answer string) (puzzle.Result, error)
+	Solved() bool
+	Attempts() int
+	Rewind(attempts int, solved bool)
+}
+
+// Snare is a room hiding traps.
+type Snare interface {
+	Room
+	Traps(trigger trap.Trigger) []*trap.Trap
+}
+
+// Market is a room where a merchant trades.
+type Market interface {
+	Room
+	Merchant() *shop.Merchant
+}
+
+// Haven is a room where the player can rest.
+type Haven interface {
+	Room
+	Sanctuary() rest.Sanctuary
 }
@@ -0,0 +1,124 @@
+package ability
+
+import (
+	"errors"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+)
+
+type ID string
+
+// Target is who an ability affects when it is used.
+type Target string
+
+const (
+	TargetSelf   Target = "self"
+	TargetSingle Target = "single"
+	TargetAll    Target = "all"
+	TargetAlly   Target = "ally"
+)
+
+// Ability is an action an actor can take instead of a basic attack. Power
+// scales the user's attack against its targets, Heal restores the user's
+// life, or the ally's for abilities targeting an ally, and Guard raises the
+// user's armour until its next turn. Effects are applied to whoever the
+// ability targets, and Element, when set, replaces the element of the user's
+// attack. An ability with a cooldown of N can be used at most once every N of
+// its user's turns.
+type Ability struct {
+	ID       ID
+	Name     string
+	Cost     int
+	Cooldown int
+	Target   Target
+	Power    float64
+	Heal     int
+	Guard    int
+	Effects  []effect.Kind
+	Element  element.Element
+}
+
+var (
+	PowerStrike = Ability{
+		ID:       "power_strike",
+		Name:     "Power strike",
+		Cost:     15,
+		Cooldown: 3,
+		Target:   TargetSingle,
+		Power:    1.5,
+	}
+	Defend = Ability{
+		ID:       "defend",
+		Name:     "Defend",
+		Cost:     5,
+		Cooldown: 2,
+		Target:   TargetSelf,
+		Guard:    15,
+	}
+	Heal = Ability{
+		ID:       "heal",
+		Name:     "Heal",
+		Cost:     20,
+		Cooldown: 4,
+		Target:   TargetSelf,
+		Heal:     30,
+	}
+	Mend = Ability{
+		ID:       "mend",
+		Name:     "Mend",
+		Cost:     15,
+		Cooldown: 3,
+		Target:   TargetAlly,
+		Heal:     25,
+	}
+	AreaAttack = Ability{
+		ID:       "area_attack",
+		Name:     "Area attack",
+		Cost:     25,
+		Cooldown: 4,
+		Target:   TargetAll,
+		Power:    0.75,
+	}
+	PoisonStrike = Ability{
+		ID:       "poison_strike",
+		Name:     "Poison strike",
+		Cost:     10,
+		Cooldown: 2,
+		Target:   TargetSingle,
+		Power:    0.5,
+		Effects:  []effect.Kind{effect.Poison},
+		Element:  element.Poison,
+	}
+)
+
+var abilities = map[ID]Ability{
+	PowerStrike.ID:  PowerStrike,
+	Defend.ID:       Defend,
+	Heal.ID:         Heal,
+	Mend.ID:         Mend,
+	AreaAttack.ID:   AreaAttack,
+	PoisonStrike.ID: PoisonStrike,
+}
+
+var unknownAbility = errors.New("unknown ability")
+
+// Register makes an ability learnable, replacing any ability with the same id.
+func Register(ability Ability) error {
+	if ability.ID == "" {
+		return errors.New("ability id cannot be empty")
+	}
+
+	abilities[ability.ID] = ability
+
+	return nil
+}
+
+func Lookup(id ID) (Ability, error) {
+	ability, ok := abilities[id]
+	if !ok {
+		return Ability{}, unknownAbility
+	}
+
+	return ability, nil
+}
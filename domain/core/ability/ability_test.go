@@ -0,0 +1,41 @@
+package ability_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when looking up a built in ability", func(t *testing.T) {
+			actual, err := ability.Lookup("power_strike")
+
+			require.NoError(t, err)
+			require.Equal(t, ability.PowerStrike, actual)
+		})
+
+		t.Run("when registering a new ability", func(t *testing.T) {
+			fireball := ability.Ability{ID: "fireball", Name: "Fireball", Cost: 30, Target: ability.TargetAll, Power: 1.2}
+
+			require.NoError(t, ability.Register(fireball))
+
+			actual, err := ability.Lookup("fireball")
+			require.NoError(t, err)
+			require.Equal(t, fireball, actual)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when ability is unknown", func(t *testing.T) {
+			_, err := ability.Lookup("teleport")
+
+			require.EqualError(t, err, "unknown ability")
+		})
+
+		t.Run("when ability has no id", func(t *testing.T) {
+			require.EqualError(t, ability.Register(ability.Ability{}), "ability id cannot be empty")
+		})
+	})
+}
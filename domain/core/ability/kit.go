@@ -0,0 +1,83 @@
+package ability
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Kit is the set of abilities an actor knows, the resource pool they are paid
+// from and the turns left before each of them can be used again.
+type Kit struct {
+	Resource  internal.Resource
+	Known     []Ability
+	Cooldowns map[ID]int
+}
+
+func (kit *Kit) Abilities() []Ability {
+	return kit.Known
+}
+
+func (kit *Kit) Learn(ability Ability) {
+	if slices.ContainsFunc(kit.Known, func(known Ability) bool { return known.ID == ability.ID }) {
+		return
+	}
+
+	kit.Known = append(kit.Known, ability)
+}
+
+func (kit *Kit) Ready(id ID) bool {
+	_, err := kit.find(id)
+
+	return err == nil
+}
+
+// Use pays for the ability and starts its cooldown.
+func (kit *Kit) Use(id ID) (Ability, error) {
+	ability, err := kit.find(id)
+	if err != nil {
+		return Ability{}, err
+	}
+
+	kit.Resource.Spend(ability.Cost)
+
+	if ability.Cooldown > 0 {
+		if kit.Cooldowns == nil {
+			kit.Cooldowns = make(map[ID]int)
+		}
+		kit.Cooldowns[id] = ability.Cooldown
+	}
+
+	return ability, nil
+}
+
+// Tick advances the kit by one of its owner's turns.
+func (kit *Kit) Tick() {
+	for id := range kit.Cooldowns {
+		kit.Cooldowns[id]--
+		if kit.Cooldowns[id] <= 0 {
+			delete(kit.Cooldowns, id)
+		}
+	}
+
+	kit.Resource.Recover()
+}
+
+func (kit *Kit) find(id ID) (Ability, error) {
+	index := slices.IndexFunc(kit.Known, func(known Ability) bool { return known.ID == id })
+	if index < 0 {
+		return Ability{}, errors.New("ability not known")
+	}
+
+	ability := kit.Known[index]
+	if kit.Cooldowns[id] > 0 {
+		return Ability{}, errors.New("ability on cooldown")
+	}
+
+	if ability.Cost > kit.Resource.Value {
+		return Ability{}, errors.New("not enough resource")
+	}
+
+	return ability, nil
+}
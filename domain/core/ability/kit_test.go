@@ -0,0 +1,78 @@
+package ability_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKit(t *testing.T) {
+	newKit := func() *ability.Kit {
+		kit := &ability.Kit{Resource: internal.Resource{Value: 40, Max: 40, Regen: 5}}
+		kit.Learn(ability.PowerStrike)
+		kit.Learn(ability.PowerStrike)
+		kit.Learn(ability.Heal)
+
+		return kit
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when learning abilities once", func(t *testing.T) {
+			require.Equal(t, []ability.Ability{ability.PowerStrike, ability.Heal}, newKit().Abilities())
+		})
+
+		t.Run("when using an ability", func(t *testing.T) {
+			kit := newKit()
+
+			actual, err := kit.Use("power_strike")
+
+			require.NoError(t, err)
+			require.Equal(t, ability.PowerStrike, actual)
+			require.Equal(t, 25, kit.Resource.Value)
+			require.False(t, kit.Ready("power_strike"))
+			require.True(t, kit.Ready("heal"))
+		})
+
+		t.Run("when the cooldown runs out", func(t *testing.T) {
+			kit := newKit()
+			_, err := kit.Use("power_strike")
+			require.NoError(t, err)
+
+			kit.Tick()
+			kit.Tick()
+			require.False(t, kit.Ready("power_strike"))
+
+			kit.Tick()
+			require.True(t, kit.Ready("power_strike"))
+			require.Equal(t, 40, kit.Resource.Value)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the ability is not known", func(t *testing.T) {
+			_, err := newKit().Use("defend")
+
+			require.EqualError(t, err, "ability not known")
+		})
+
+		t.Run("when the ability is on cooldown", func(t *testing.T) {
+			kit := newKit()
+			_, err := kit.Use("heal")
+			require.NoError(t, err)
+
+			_, err = kit.Use("heal")
+			require.EqualError(t, err, "ability on cooldown")
+		})
+
+		t.Run("when there is not enough resource", func(t *testing.T) {
+			kit := newKit()
+			kit.Resource.Value = 10
+
+			_, err := kit.Use("power_strike")
+			require.EqualError(t, err, "not enough resource")
+			require.Equal(t, 10, kit.Resource.Value)
+		})
+	})
+}
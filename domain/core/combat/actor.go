@@ -0,0 +1,30 @@
+package combat
+
+import (
+	"fmt"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Actor is anything that can take part in an encounter, such as a player or
+// an enemy.
+type Actor interface {
+	fmt.Stringer
+	Offense() internal.Attack
+	Odds() internal.Precision
+	Health() int
+	MaxHealth() int
+	Receive(roll int, damage element.Element) internal.Hit
+	Heal(amount int) int
+	Guard(amount int)
+	StartTurn()
+	Abilities() []ability.Ability
+	Ready(id ability.ID) bool
+	Use(id ability.ID) (ability.Ability, error)
+	ApplyEffect(applied effect.Effect) bool
+	TickEffects(phase effect.Phase) []effect.Tick
+	Stunned() bool
+}
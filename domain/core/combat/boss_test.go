@@ -0,0 +1,58 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncounterBoss(t *testing.T) {
+	t.Run("reports every phase the boss moves through", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("lair", subject, dicetest.Rolls())
+		attacker := player.New("Elmster")
+		attacker.Attack = internal.Attack{Min: 300, Max: 300}
+		boss := enemy.NewBoss(enemy.Dragon)
+		boss.Armour.Value = 0
+		boss.Life.Value = 250
+
+		_, err := encounter.Strike(attacker, boss)
+
+		require.NoError(t, err)
+		require.Equal(t, []event.Kind{event.AttackPerformed, event.ActorDied}, kinds(subject.notifyCalls), "defeated bosses stay put")
+
+		subject = &MockSubject{}
+		encounter = combat.NewEncounter("lair", subject, dicetest.Rolls())
+		attacker.Attack = internal.Attack{Min: 250, Max: 250}
+		boss = enemy.NewBoss(enemy.Dragon)
+		boss.Armour.Value = 0
+
+		_, err = encounter.Strike(attacker, boss)
+
+		require.NoError(t, err)
+		require.Equal(t, []event.Kind{event.AttackPerformed, event.PhaseChanged, event.PhaseChanged}, kinds(subject.notifyCalls))
+		require.Equal(t, "Enraged", subject.notifyCalls[1].(event.Combat).Phase)
+		require.Equal(t, "Cornered", subject.notifyCalls[2].(event.Combat).Phase)
+	})
+
+	t.Run("fights as its phase dictates", func(t *testing.T) {
+		boss := enemy.NewBoss(enemy.Dragon)
+		target := player.New("Elmster")
+		strategy := combat.StrategyFor(enemy.Dragon, dicetest.Rolls())
+
+		require.Equal(t, ability.PowerStrike.ID, strategy.Choose(boss, nil, []combat.Actor{target}).Ability)
+
+		boss.Life.Value = 50
+		boss.Advance()
+		boss.Advance()
+
+		require.Equal(t, combat.Choice{Ability: ability.Heal.ID}, strategy.Choose(boss, nil, []combat.Actor{target}))
+	})
+}
@@ -0,0 +1,36 @@
+package combat
+
+import "github.com/pedrokunz/go-design-patterns/domain/core/ability"
+
+// Choice is what an actor decided to do with its turn. An empty ability is a
+// basic attack against the first target, and fleeing ends the actor's part in
+// the encounter.
+type Choice struct {
+	Ability ability.ID
+	Targets []Actor
+	Flee    bool
+}
+
+// Choose makes a fixed choice usable wherever a chooser is expected.
+func (choice Choice) Choose(Actor, []Actor, []Actor) Choice {
+	return choice
+}
+
+// Chooser decides what an actor does on its turn, given who fights alongside
+// it and who it fights against.
+type Chooser interface {
+	Choose(actor Actor, allies []Actor, opponents []Actor) Choice
+}
+
+// BasicAttack always attacks the first opponent still standing.
+type BasicAttack struct{}
+
+func (BasicAttack) Choose(_ Actor, _ []Actor, opponents []Actor) Choice {
+	for _, opponent := range opponents {
+		if opponent.Health() > 0 {
+			return Choice{Targets: []Actor{opponent}}
+		}
+	}
+
+	return Choice{}
+}
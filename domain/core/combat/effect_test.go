@@ -0,0 +1,115 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func kinds(events []event.Event) []event.Kind {
+	actual := make([]event.Kind, 0, len(events))
+	for _, evt := range events {
+		actual = append(actual, evt.Type())
+	}
+
+	return actual
+}
+
+func TestEncounterEffects(t *testing.T) {
+	t.Run("abilities apply their effects to the targets", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		goblin := enemy.New(enemy.Goblin)
+		goblin.Attack = internal.Attack{Min: 10, Max: 10}
+		elmster := player.New("Elmster")
+
+		_, err := encounter.Perform(goblin, ability.PoisonStrike.ID, elmster)
+		require.NoError(t, err)
+		require.True(t, elmster.Effects.Has(effect.Poison))
+		require.Equal(t, []event.Kind{event.AbilityUsed, event.AttackPerformed, event.EffectApplied}, kinds(subject.notifyCalls))
+
+		subject.notifyCalls = nil
+		_, err = encounter.Perform(elmster, "", goblin)
+		require.NoError(t, err)
+		require.Equal(t, event.Combat{
+			Kind:      event.EffectTicked,
+			Encounter: "cave",
+			Turn:      2,
+			Actor:     "Elmster",
+			Effect:    "poison",
+			Damage:    4,
+			Life:      91,
+		}, subject.notifyCalls[0])
+	})
+
+	t.Run("stunned actors skip their turn", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		orc := enemy.New(enemy.Orc)
+		require.NoError(t, encounter.Afflict(orc, effect.New(effect.Stun, "trap")))
+
+		hits, err := encounter.Perform(orc, "", player.New("Elmster"))
+
+		require.NoError(t, err)
+		require.Empty(t, hits)
+		require.Equal(t, []event.Kind{event.EffectApplied, event.ActorStunned, event.EffectExpired}, kinds(subject.notifyCalls))
+		require.False(t, orc.Stunned())
+	})
+
+	t.Run("immune actors ignore the effect", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+
+		require.NoError(t, encounter.Afflict(enemy.New(enemy.Troll), effect.New(effect.Bleed, "Elmster")))
+		require.Empty(t, subject.notifyCalls)
+	})
+
+	t.Run("effects can kill", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		goblin := enemy.New(enemy.Goblin)
+		goblin.Life.Value = 1
+		require.NoError(t, encounter.Afflict(goblin, effect.New(effect.Poison, "Elmster")))
+
+		hits, err := encounter.Perform(goblin, "", player.New("Elmster"))
+
+		require.NoError(t, err)
+		require.Empty(t, hits)
+		require.Equal(t, event.Combat{
+			Kind:      event.ActorDied,
+			Encounter: "cave",
+			Turn:      1,
+			Actor:     "Goblin",
+			Killer:    "Elmster",
+			Life:      -1,
+		}, subject.notifyCalls[len(subject.notifyCalls)-1])
+	})
+
+	t.Run("regeneration heals at the end of the turn", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		elmster := player.New("Elmster")
+		elmster.Life.Value = 50
+		require.NoError(t, encounter.Afflict(elmster, effect.New(effect.Regeneration, "potion")))
+
+		_, err := encounter.Perform(elmster, "", enemy.New(enemy.Goblin))
+
+		require.NoError(t, err)
+		require.Equal(t, 58, elmster.Health())
+		require.Equal(t, 8, subject.notifyCalls[len(subject.notifyCalls)-1].(event.Combat).Healed)
+	})
+
+	t.Run("fails when the target is nil", func(t *testing.T) {
+		encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+
+		require.EqualError(t, encounter.Afflict(nil, effect.New(effect.Stun, "trap")), "actor cannot be nil")
+	})
+}
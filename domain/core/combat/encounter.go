@@ -0,0 +1,463 @@
+package combat
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+)
+
+// Encounter resolves attacks between actors and reports every one of them to
+// the notifier so observers can keep track of the fight.
+type Encounter struct {
+	ID       string
+	Turn     int
+	notifier observer.Notifier
+	random   dice.Random
+	fled     map[Actor]bool
+}
+
+// NewEncounter creates an encounter resolving attacks with the given random
+// source, or with a freshly seeded one when random is nil.
+func NewEncounter(id string, notifier observer.Notifier, random dice.Random) *Encounter {
+	if random == nil {
+		random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return &Encounter{ID: id, notifier: notifier, random: random, fled: map[Actor]bool{}}
+}
+
+// Fled reports whether the actor ran away from the encounter.
+func (encounter *Encounter) Fled(actor Actor) bool {
+	return encounter.fled[actor]
+}
+
+// TakeTurn starts the actor's turn, lets the chooser decide what the actor
+// does and performs it. Status effects take hold at the start and at the end
+// of the turn, and stunned actors skip straight to the end.
+func (encounter *Encounter) TakeTurn(actor Actor, chooser Chooser, allies []Actor, opponents []Actor) ([]internal.Hit, error) {
+	if actor == nil || chooser == nil {
+		return nil, errors.New("actor cannot be nil")
+	}
+
+	encounter.Turn++
+	actor.StartTurn()
+
+	err := encounter.tick(actor, effect.TurnStart)
+	if actor.Health() <= 0 {
+		return nil, err
+	}
+
+	var hits []internal.Hit
+	if actor.Stunned() {
+		err = errors.Join(err, encounter.notify(event.Combat{
+			Kind:   event.ActorStunned,
+			Actor:  actor.String(),
+			Effect: string(effect.Stun),
+			Life:   actor.Health(),
+		}))
+	} else {
+		choice := chooser.Choose(actor, allies, opponents)
+		if slices.Contains(choice.Targets, nil) {
+			return nil, errors.New("actor cannot be nil")
+		}
+
+		if choice.Flee {
+			encounter.fled[actor] = true
+
+			return nil, errors.Join(err, encounter.notify(event.Combat{
+				Kind:  event.ActorFled,
+				Actor: actor.String(),
+				Life:  actor.Health(),
+			}))
+		}
+
+		var resolveErr error
+		hits, resolveErr = encounter.resolve(actor, choice.Ability, choice.Targets)
+		err = errors.Join(err, resolveErr)
+	}
+
+	return hits, errors.Join(err, encounter.tick(actor, effect.TurnEnd))
+}
+
+// Strike is a basic attack from attacker against target.
+func (encounter *Encounter) Strike(attacker Actor, target Actor) (internal.Hit, error) {
+	if target == nil {
+		return internal.Hit{}, errors.New("actor cannot be nil")
+	}
+
+	hits, err := encounter.Perform(attacker, "", target)
+	if len(hits) == 0 {
+		return internal.Hit{}, err
+	}
+
+	return hits[0], err
+}
+
+// Perform takes the actor's turn using the ability against the targets, or a
+// basic attack against the first target when no ability is given.
+func (encounter *Encounter) Perform(actor Actor, id ability.ID, targets ...Actor) ([]internal.Hit, error) {
+	return encounter.TakeTurn(actor, Choice{Ability: id, Targets: targets}, nil, nil)
+}
+
+func (encounter *Encounter) resolve(actor Actor, id ability.ID, targets []Actor) ([]internal.Hit, error) {
+	if id == "" {
+		if len(targets) == 0 {
+			return nil, errors.New("attack needs a target")
+		}
+
+		hit, err := encounter.attack(actor, targets[0], actor.Offense(), "")
+
+		return []internal.Hit{hit}, err
+	}
+
+	used, err := actor.Use(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encounter.notify(event.Combat{
+		Kind:    event.AbilityUsed,
+		Actor:   actor.String(),
+		Ability: string(used.ID),
+		Life:    actor.Health(),
+	})
+
+	if used.Guard > 0 {
+		actor.Guard(used.Guard)
+	}
+
+	if used.Heal > 0 {
+		err = errors.Join(err, encounter.heal(actor, used, targets))
+	}
+
+	switch used.Target {
+	case ability.TargetSingle, ability.TargetAlly:
+		targets = targets[:min(len(targets), 1)]
+	case ability.TargetSelf:
+		targets = []Actor{actor}
+	}
+
+	hits := make([]internal.Hit, 0, len(targets))
+	for _, target := range targets {
+		if target.Health() <= 0 {
+			continue
+		}
+
+		if used.Power > 0 && target != actor {
+			attack := scale(actor.Offense(), used.Power)
+			if used.Element != "" {
+				attack.Element = used.Element
+			}
+
+			hit, attackErr := encounter.attack(actor, target, attack, used.ID)
+			hits = append(hits, hit)
+			err = errors.Join(err, attackErr)
+
+			if hit.Outcome == internal.OutcomeMiss || hit.Outcome == internal.OutcomeDodge {
+				continue
+			}
+		}
+
+		for _, kind := range used.Effects {
+			err = errors.Join(err, encounter.afflict(target, effect.New(kind, actor.String())))
+		}
+	}
+
+	return hits, err
+}
+
+// heal restores the user's life, or the first target's for abilities that
+// target an ally.
+func (encounter *Encounter) heal(actor Actor, used ability.Ability, targets []Actor) error {
+	combat := event.Combat{
+		Kind:    event.ActorHealed,
+		Actor:   actor.String(),
+		Ability: string(used.ID),
+	}
+
+	recipient := actor
+	if used.Target == ability.TargetAlly {
+		if len(targets) == 0 || targets[0].Health() <= 0 {
+			return errors.New("heal needs a living ally")
+		}
+
+		recipient = targets[0]
+		combat.Target = recipient.String()
+	}
+
+	combat.Healed = recipient.Heal(used.Heal)
+	combat.Life = recipient.Health()
+
+	return encounter.notify(combat)
+}
+
+// land rolls whether the attack misses, is dodged or lands as a critical hit
+// and applies whatever damage gets through to the target.
+func (encounter *Encounter) land(odds internal.Precision, target Actor, attack internal.Attack) internal.Hit {
+	if encounter.random.Intn(100) >= odds.Accuracy {
+		return internal.Hit{Outcome: internal.OutcomeMiss}
+	}
+
+	if encounter.random.Intn(100) >= 100-target.Odds().Evasion {
+		return internal.Hit{Outcome: internal.OutcomeDodge}
+	}
+
+	roll := attack.RollWith(encounter.random)
+	if encounter.random.Intn(100) >= 100-odds.CritChance {
+		hit := target.Receive(int(math.Round(float64(roll)*max(odds.CritMultiplier, 1))), attack.Element)
+		hit.Outcome = internal.OutcomeCritical
+
+		return hit
+	}
+
+	return target.Receive(roll, attack.Element)
+}
+
+func (encounter *Encounter) attack(attacker Actor, target Actor, attack internal.Attack, id ability.ID) (internal.Hit, error) {
+	hit := encounter.land(attacker.Odds(), target, attack)
+
+	err := encounter.notify(event.Combat{
+		Kind:      event.AttackPerformed,
+		Actor:     attacker.String(),
+		Target:    target.String(),
+		Ability:   string(id),
+		Outcome:   string(hit.Outcome),
+		Element:   string(hit.Element),
+		Roll:      hit.Roll,
+		Mitigated: hit.Mitigated,
+		Damage:    hit.Damage,
+		Life:      target.Health(),
+	})
+
+	if landed(hit) {
+		err = errors.Join(err, encounter.wear(attacker, item.Weapon), encounter.wear(target, item.Armour))
+	}
+
+	if target.Health() <= 0 {
+		err = errors.Join(err, encounter.died(target, attacker.String()))
+	}
+
+	return hit, errors.Join(err, encounter.advance(target))
+}
+
+// landed reports whether the attack got to the target, wearing down the gear
+// on either side.
+func landed(hit internal.Hit) bool {
+	return hit.Outcome != internal.OutcomeMiss && hit.Outcome != internal.OutcomeDodge
+}
+
+// equipped is an actor, such as a player, whose gear wears out with use.
+type equipped interface {
+	Wear(slot item.Type) (item.Item, bool)
+}
+
+// wear uses up the actor's gear in the slot once, reporting it when it breaks.
+func (encounter *Encounter) wear(actor Actor, slot item.Type) error {
+	wearer, ok := actor.(equipped)
+	if !ok {
+		return nil
+	}
+
+	worn, broke := wearer.Wear(slot)
+	if !broke {
+		return nil
+	}
+
+	return encounter.notify(event.Combat{
+		Kind:  event.ItemBroken,
+		Actor: actor.String(),
+		Item:  worn.Title(),
+		Life:  actor.Health(),
+	})
+}
+
+// phased is an actor, such as a boss, whose fight moves through phases as it
+// loses life.
+type phased interface {
+	Advance() (enemy.Phase, bool)
+}
+
+// advance moves the actor through every phase its life has fallen into.
+func (encounter *Encounter) advance(actor Actor) (err error) {
+	boss, ok := actor.(phased)
+	if !ok {
+		return nil
+	}
+
+	for phase, changed := boss.Advance(); changed; phase, changed = boss.Advance() {
+		err = errors.Join(err, encounter.notify(event.Combat{
+			Kind:  event.PhaseChanged,
+			Actor: actor.String(),
+			Phase: phase.Name,
+			Life:  actor.Health(),
+		}))
+	}
+
+	return err
+}
+
+// Spring sets the trap off against the target, dealing its damage and
+// applying its effect unless the target dodges.
+func (encounter *Encounter) Spring(sprung *trap.Trap, target Actor) (internal.Hit, error) {
+	if sprung == nil || target == nil {
+		return internal.Hit{}, errors.New("trap and target cannot be nil")
+	}
+
+	if !sprung.Armed() {
+		return internal.Hit{}, errors.New("trap is not armed")
+	}
+
+	sprung.Sprung = true
+	hit := encounter.land(sprung.Odds(), target, sprung.Attack)
+
+	err := encounter.notify(event.Combat{
+		Kind:      event.TrapTriggered,
+		Actor:     sprung.Name,
+		Target:    target.String(),
+		Outcome:   string(hit.Outcome),
+		Element:   string(hit.Element),
+		Roll:      hit.Roll,
+		Mitigated: hit.Mitigated,
+		Damage:    hit.Damage,
+		Life:      target.Health(),
+	})
+
+	if landed(hit) {
+		err = errors.Join(err, encounter.wear(target, item.Armour))
+	}
+
+	if target.Health() <= 0 {
+		return hit, errors.Join(err, encounter.died(target, sprung.Name))
+	}
+
+	if sprung.Effect != "" && hit.Outcome != internal.OutcomeDodge {
+		err = errors.Join(err, encounter.afflict(target, effect.New(sprung.Effect, sprung.Name)))
+	}
+
+	return hit, errors.Join(err, encounter.advance(target))
+}
+
+// Penalize deals the penalty for failing a puzzle to the target. Nothing
+// dodges a penalty, though the target's armour and resistances soak it up as
+// they would a physical hit.
+func (encounter *Encounter) Penalize(source string, penalty int, target Actor) (internal.Hit, error) {
+	if target == nil {
+		return internal.Hit{}, errors.New("actor cannot be nil")
+	}
+
+	hit := target.Receive(penalty, element.Physical)
+
+	err := encounter.notify(event.Combat{
+		Kind:      event.PenaltyDealt,
+		Actor:     source,
+		Target:    target.String(),
+		Outcome:   string(hit.Outcome),
+		Element:   string(hit.Element),
+		Roll:      hit.Roll,
+		Mitigated: hit.Mitigated,
+		Damage:    hit.Damage,
+		Life:      target.Health(),
+	})
+
+	if target.Health() <= 0 {
+		return hit, errors.Join(err, encounter.died(target, source))
+	}
+
+	return hit, errors.Join(err, encounter.advance(target))
+}
+
+// Afflict applies a status effect to the target outside of an ability, such
+// as from a potion or a trap.
+func (encounter *Encounter) Afflict(target Actor, applied effect.Effect) error {
+	if target == nil {
+		return errors.New("actor cannot be nil")
+	}
+
+	return encounter.afflict(target, applied)
+}
+
+func (encounter *Encounter) afflict(target Actor, applied effect.Effect) error {
+	if target.Health() <= 0 || !target.ApplyEffect(applied) {
+		return nil
+	}
+
+	return encounter.notify(event.Combat{
+		Kind:   event.EffectApplied,
+		Actor:  applied.Source,
+		Target: target.String(),
+		Effect: string(applied.Kind),
+		Life:   target.Health(),
+	})
+}
+
+// tick resolves the actor's status effects for the phase, reporting the
+// effect that dealt the last damage as the killer if the actor dies.
+func (encounter *Encounter) tick(actor Actor, phase effect.Phase) (err error) {
+	killer := ""
+
+	for _, tick := range actor.TickEffects(phase) {
+		combat := event.Combat{
+			Kind:   event.EffectTicked,
+			Actor:  actor.String(),
+			Effect: string(tick.Effect.Kind),
+			Life:   actor.Health(),
+		}
+
+		switch {
+		case tick.Expired:
+			combat.Kind = event.EffectExpired
+		case tick.Effect.Kind == effect.Regeneration:
+			combat.Healed = tick.Amount
+		default:
+			combat.Damage = tick.Amount
+			if tick.Amount > 0 {
+				killer = tick.Effect.Source
+			}
+		}
+
+		err = errors.Join(err, encounter.notify(combat))
+	}
+
+	if killer != "" && actor.Health() <= 0 {
+		err = errors.Join(err, encounter.died(actor, killer))
+	}
+
+	return errors.Join(err, encounter.advance(actor))
+}
+
+func (encounter *Encounter) died(actor Actor, killer string) error {
+	return encounter.notify(event.Combat{
+		Kind:   event.ActorDied,
+		Actor:  actor.String(),
+		Killer: killer,
+		Life:   actor.Health(),
+	})
+}
+
+// notify stamps the combat event with the encounter and turn it happened in.
+func (encounter *Encounter) notify(combat event.Combat) error {
+	combat.Encounter = encounter.ID
+	combat.Turn = encounter.Turn
+
+	return encounter.notifier.Notify(combat)
+}
+
+func scale(attack internal.Attack, power float64) internal.Attack {
+	attack.Min = int(math.Round(float64(attack.Min) * power))
+	attack.Max = int(math.Round(float64(attack.Max) * power))
+
+	return attack
+}
@@ -0,0 +1,265 @@
+package combat_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSubject struct {
+	notifyCalls []event.Event
+	notifyErr   error
+}
+
+func (subject *MockSubject) Attach(observer observer.Observer) error {
+	return nil
+}
+
+func (subject *MockSubject) Notify(event event.Event) error {
+	subject.notifyCalls = append(subject.notifyCalls, event)
+	return subject.notifyErr
+}
+
+func TestEncounter(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when an attack lands", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+			attacker := player.New("Elmster")
+			attacker.Attack = internal.Attack{Min: 10, Max: 11}
+			target := enemy.New(enemy.Goblin)
+			target.Armour.Value = 3
+
+			hit, err := encounter.Strike(attacker, target)
+
+			require.NoError(t, err)
+			require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 10, Mitigated: 3, Damage: 7}, hit)
+			require.Equal(t, []event.Event{
+				event.Combat{
+					Kind:      event.AttackPerformed,
+					Encounter: "cave",
+					Turn:      1,
+					Actor:     "Elmster",
+					Target:    "Goblin",
+					Outcome:   "hit",
+					Element:   "physical",
+					Roll:      10,
+					Mitigated: 3,
+					Damage:    7,
+					Life:      93,
+				},
+			}, subject.notifyCalls)
+		})
+
+		t.Run("when an attack kills the target", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+			attacker := enemy.New(enemy.Troll)
+			attacker.Attack = internal.Attack{Min: 10, Max: 11}
+			target := player.New("Elmster")
+			target.Life.Value = 5
+
+			_, err := encounter.Strike(attacker, target)
+
+			require.NoError(t, err)
+			require.Len(t, subject.notifyCalls, 2)
+			require.Equal(t, event.Combat{
+				Kind:      event.ActorDied,
+				Encounter: "cave",
+				Turn:      1,
+				Actor:     "Elmster",
+				Killer:    "Troll",
+				Life:      -5,
+			}, subject.notifyCalls[1])
+		})
+
+		t.Run("when a landed attack wears the gear on both sides", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+			attacker := player.New("Elmster")
+			axe := item.Item{ID: "old-axe", Name: "Old axe", Type: item.Weapon, Power: 2, Durability: 1}.Clone()
+			attacker.Collect(axe)
+			require.NoError(t, attacker.Equip(axe))
+			defender := player.New("Minsc")
+			mail := item.Item{ID: "mail", Name: "Mail", Type: item.Armour, Power: 2, Durability: 2}.Clone()
+			defender.Collect(mail)
+			require.NoError(t, defender.Equip(mail))
+
+			_, err := encounter.Strike(attacker, defender)
+
+			require.NoError(t, err)
+			require.Len(t, subject.notifyCalls, 2)
+			require.Equal(t, event.Combat{
+				Kind:      event.ItemBroken,
+				Encounter: "cave",
+				Turn:      1,
+				Actor:     "Elmster",
+				Item:      "Old axe",
+				Life:      100,
+			}, subject.notifyCalls[1])
+			require.NotContains(t, attacker.Equipment, item.Weapon)
+			current, _ := defender.Equipment[item.Armour].Condition()
+			require.Equal(t, 1, current)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when an attack has no target", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.Perform(player.New("Elmster"), "")
+
+			require.EqualError(t, err, "attack needs a target")
+		})
+
+		t.Run("when the ability cannot be used", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+
+			_, err := encounter.Perform(player.New("Elmster"), ability.PowerStrike.ID, enemy.New(enemy.Goblin))
+
+			require.EqualError(t, err, "ability not known")
+			require.Empty(t, subject.notifyCalls)
+		})
+
+		t.Run("when an actor is nil", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.Strike(player.New("Elmster"), nil)
+
+			require.EqualError(t, err, "actor cannot be nil")
+		})
+
+		t.Run("when observers fail", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{notifyErr: errors.New("observer error")}, dicetest.Rolls())
+
+			_, err := encounter.Strike(player.New("Elmster"), enemy.New(enemy.Goblin))
+
+			require.ErrorContains(t, err, "observer error")
+		})
+	})
+}
+
+func TestEncounterAbilities(t *testing.T) {
+	t.Run("power strike scales the attack", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		attacker := player.NewWithClass("Elmster", player.Warrior)
+		attacker.Attack = internal.Attack{Min: 10, Max: 10}
+		target := enemy.New(enemy.Goblin)
+
+		hits, err := encounter.Perform(attacker, ability.PowerStrike.ID, target, enemy.New(enemy.Orc))
+
+		require.NoError(t, err)
+		require.Equal(t, []internal.Hit{{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 15, Damage: 15}}, hits)
+		require.Equal(t, 85, target.Health())
+		require.Equal(t, 45, attacker.Resource.Value, "paid for the ability")
+		require.Equal(t, event.AbilityUsed, subject.notifyCalls[0].Type())
+		require.Equal(t, "power_strike", subject.notifyCalls[1].(event.Combat).Ability)
+	})
+
+	t.Run("area attack hits every target still standing", func(t *testing.T) {
+		encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+		attacker := player.NewWithClass("Elmster", player.Mage)
+		attacker.Attack = internal.Attack{Min: 20, Max: 20}
+		goblin := enemy.New(enemy.Goblin)
+		orc := enemy.New(enemy.Orc)
+		troll := enemy.New(enemy.Troll)
+		troll.Life.Value = 0
+
+		hits, err := encounter.Perform(attacker, ability.AreaAttack.ID, goblin, orc, troll)
+
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+		require.Equal(t, 85, goblin.Health())
+		require.Equal(t, 87, orc.Health(), "orcs shrug off some physical damage")
+	})
+
+	t.Run("heal restores the user", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		troll := enemy.New(enemy.Troll)
+		troll.Life.Value = 50
+
+		hits, err := encounter.Perform(troll, ability.Heal.ID)
+
+		require.NoError(t, err)
+		require.Empty(t, hits)
+		require.Equal(t, 80, troll.Health())
+		require.Equal(t, event.Combat{
+			Kind:      event.ActorHealed,
+			Encounter: "cave",
+			Turn:      1,
+			Actor:     "Troll",
+			Ability:   "heal",
+			Healed:    30,
+			Life:      80,
+		}, subject.notifyCalls[1])
+	})
+
+	t.Run("defend guards until the next turn", func(t *testing.T) {
+		encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+		troll := enemy.New(enemy.Troll)
+		attacker := player.New("Elmster")
+		attacker.Attack = internal.Attack{Min: 10, Max: 11}
+
+		_, err := encounter.Perform(troll, ability.Defend.ID)
+		require.NoError(t, err)
+
+		hit, err := encounter.Strike(attacker, troll)
+		require.NoError(t, err)
+		require.Zero(t, hit.Damage)
+
+		_, err = encounter.Strike(troll, attacker)
+		require.NoError(t, err)
+		require.Zero(t, troll.Armour.Bonus)
+	})
+}
+
+func TestEncounterTakeTurn(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the chooser picks a basic attack", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+			attacker := player.New("Elmster")
+			attacker.Attack = internal.Attack{Min: 10, Max: 11}
+			dead := enemy.New(enemy.Orc)
+			dead.Life.Value = 0
+			target := enemy.New(enemy.Goblin)
+
+			hits, err := encounter.TakeTurn(attacker, combat.BasicAttack{}, nil, []combat.Actor{dead, target})
+
+			require.NoError(t, err)
+			require.Len(t, hits, 1)
+			require.Equal(t, 90, target.Health())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when nobody is left to attack", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.TakeTurn(player.New("Elmster"), combat.BasicAttack{}, nil, nil)
+
+			require.EqualError(t, err, "attack needs a target")
+		})
+
+		t.Run("when the chooser is nil", func(t *testing.T) {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.TakeTurn(player.New("Elmster"), nil, nil, nil)
+
+			require.EqualError(t, err, "actor cannot be nil")
+		})
+	})
+}
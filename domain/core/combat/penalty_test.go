@@ -0,0 +1,64 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncounterPenalize(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the penalty hurts", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("vault", subject, dicetest.Rolls())
+			target := player.New("Elmster")
+			target.Armour.Value = 2
+
+			hit, err := encounter.Penalize("Sphinx", 10, target)
+
+			require.NoError(t, err)
+			require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 10, Mitigated: 2, Damage: 8}, hit)
+			require.Equal(t, event.Combat{
+				Kind:      event.PenaltyDealt,
+				Encounter: "vault",
+				Actor:     "Sphinx",
+				Target:    "Elmster",
+				Outcome:   string(internal.OutcomeHit),
+				Element:   string(element.Physical),
+				Roll:      10,
+				Mitigated: 2,
+				Damage:    8,
+				Life:      92,
+			}, subject.notifyCalls[0])
+		})
+
+		t.Run("when the penalty kills", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("vault", subject, dicetest.Rolls())
+			target := player.New("Elmster")
+			target.Life.Value = 5
+
+			_, err := encounter.Penalize("Sphinx", 10, target)
+
+			require.NoError(t, err)
+			require.Equal(t, []event.Kind{event.PenaltyDealt, event.ActorDied}, kinds(subject.notifyCalls))
+			require.Equal(t, "Sphinx", subject.notifyCalls[1].(event.Combat).Killer)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the target is nil", func(t *testing.T) {
+			encounter := combat.NewEncounter("vault", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.Penalize("Sphinx", 10, nil)
+
+			require.EqualError(t, err, "actor cannot be nil")
+		})
+	})
+}
@@ -0,0 +1,100 @@
+package combat_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncounterPrecision(t *testing.T) {
+	newFight := func(rolls ...int) (*combat.Encounter, *MockSubject, *player.Player, *enemy.Enemy) {
+		subject := &MockSubject{}
+		attacker := player.New("Elmster")
+		attacker.Attack = internal.Attack{Min: 10, Max: 10}
+		attacker.Precision = internal.Precision{Accuracy: 80, CritChance: 10, CritMultiplier: 2}
+		target := enemy.New(enemy.Goblin)
+		target.Precision.Evasion = 10
+
+		return combat.NewEncounter("cave", subject, dicetest.Rolls(rolls...)), subject, attacker, target
+	}
+
+	t.Run("misses when the accuracy roll fails", func(t *testing.T) {
+		encounter, subject, attacker, target := newFight(80)
+
+		hit, err := encounter.Strike(attacker, target)
+
+		require.NoError(t, err)
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeMiss}, hit)
+		require.Equal(t, 100, target.Health())
+		require.Equal(t, "miss", subject.notifyCalls[0].(event.Combat).Outcome)
+	})
+
+	t.Run("is dodged when the evasion roll succeeds", func(t *testing.T) {
+		encounter, subject, attacker, target := newFight(79, 90)
+
+		hit, err := encounter.Strike(attacker, target)
+
+		require.NoError(t, err)
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeDodge}, hit)
+		require.Equal(t, "dodge", subject.notifyCalls[0].(event.Combat).Outcome)
+	})
+
+	t.Run("multiplies damage on a critical hit", func(t *testing.T) {
+		encounter, subject, attacker, target := newFight(0, 89, 90)
+
+		hit, err := encounter.Strike(attacker, target)
+
+		require.NoError(t, err)
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeCritical, Element: element.Physical, Roll: 20, Damage: 20}, hit)
+		require.Equal(t, 80, target.Health())
+		require.Equal(t, "crit", subject.notifyCalls[0].(event.Combat).Outcome)
+	})
+
+	t.Run("does not apply effects when the attack misses", func(t *testing.T) {
+		encounter, _, _, target := newFight(99)
+		goblin := enemy.New(enemy.Goblin)
+
+		_, err := encounter.Perform(goblin, ability.PoisonStrike.ID, target)
+
+		require.NoError(t, err)
+		require.False(t, target.Effects.Has(effect.Poison))
+	})
+
+	t.Run("is deterministic for a seeded source", func(t *testing.T) {
+		fight := func() []int {
+			encounter := combat.NewEncounter("cave", &MockSubject{}, rand.New(rand.NewSource(42)))
+			attacker := player.New("Elmster")
+			target := enemy.New(enemy.Troll)
+			target.Life.Value = 1000
+
+			damage := make([]int, 0)
+			for range 20 {
+				hit, err := encounter.Strike(attacker, target)
+				require.NoError(t, err)
+				damage = append(damage, hit.Damage)
+			}
+
+			return damage
+		}
+
+		require.Equal(t, fight(), fight())
+	})
+
+	t.Run("uses a fresh source when none is given", func(t *testing.T) {
+		encounter := combat.NewEncounter("cave", &MockSubject{}, nil)
+
+		_, err := encounter.Strike(player.New("Elmster"), enemy.New(enemy.Goblin))
+
+		require.NoError(t, err)
+	})
+}
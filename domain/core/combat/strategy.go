@@ -0,0 +1,195 @@
+package combat
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+)
+
+// Strategy builds the chooser an enemy fights with, drawing any luck it needs
+// from random.
+type Strategy func(random dice.Random) Chooser
+
+// Aggressive spends its turns on the hardest hitting ability that is ready,
+// or a basic attack, against a random opponent still standing. Without a
+// random source it goes after the first one.
+type Aggressive struct {
+	Random dice.Random
+}
+
+func (aggressive Aggressive) Choose(actor Actor, _ []Actor, opponents []Actor) Choice {
+	standing := living(opponents)
+	if len(standing) == 0 {
+		return Choice{}
+	}
+
+	target := standing[0]
+	if aggressive.Random != nil {
+		target = standing[aggressive.Random.Intn(len(standing))]
+	}
+
+	choice := Choice{Targets: []Actor{target}}
+	power := 1.0
+	for _, known := range actor.Abilities() {
+		if known.Power > power && known.Target != ability.TargetSelf && actor.Ready(known.ID) {
+			power = known.Power
+			choice.Ability = known.ID
+			if known.Target == ability.TargetAll {
+				choice.Targets = standing
+			}
+		}
+	}
+
+	return choice
+}
+
+// Defensive guards itself, or heals itself, once its life falls below the
+// threshold percentage and otherwise fights like the fallback.
+type Defensive struct {
+	Threshold int
+	Fallback  Chooser
+}
+
+func (defensive Defensive) Choose(actor Actor, allies []Actor, opponents []Actor) Choice {
+	if wounded(actor, defensive.Threshold) {
+		for _, known := range actor.Abilities() {
+			if known.Target == ability.TargetSelf && (known.Guard > 0 || known.Heal > 0) && actor.Ready(known.ID) {
+				return Choice{Ability: known.ID}
+			}
+		}
+	}
+
+	return fallback(defensive.Fallback).Choose(actor, allies, opponents)
+}
+
+// Cowardly runs away once its life falls below the threshold percentage,
+// succeeding on a roll under Chance out of a hundred, and otherwise fights
+// like the fallback. Without a random source it always runs.
+type Cowardly struct {
+	Threshold int
+	Chance    int
+	Random    dice.Random
+	Fallback  Chooser
+}
+
+func (cowardly Cowardly) Choose(actor Actor, allies []Actor, opponents []Actor) Choice {
+	if wounded(actor, cowardly.Threshold) && (cowardly.Random == nil || cowardly.Random.Intn(100) < cowardly.Chance) {
+		return Choice{Flee: true}
+	}
+
+	return fallback(cowardly.Fallback).Choose(actor, allies, opponents)
+}
+
+// Support heals the most wounded of itself and its allies once their life
+// falls below the threshold percentage and otherwise fights like the
+// fallback.
+type Support struct {
+	Threshold int
+	Fallback  Chooser
+}
+
+func (support Support) Choose(actor Actor, allies []Actor, opponents []Actor) Choice {
+	var patient Actor
+	for _, ally := range append([]Actor{actor}, living(allies)...) {
+		if wounded(ally, support.Threshold) && (patient == nil || ally.Health()*patient.MaxHealth() < patient.Health()*ally.MaxHealth()) {
+			patient = ally
+		}
+	}
+
+	if patient != nil {
+		for _, known := range actor.Abilities() {
+			if known.Heal <= 0 || !actor.Ready(known.ID) {
+				continue
+			}
+
+			if known.Target == ability.TargetAlly {
+				return Choice{Ability: known.ID, Targets: []Actor{patient}}
+			}
+
+			if known.Target == ability.TargetSelf && patient == actor {
+				return Choice{Ability: known.ID}
+			}
+		}
+	}
+
+	return fallback(support.Fallback).Choose(actor, allies, opponents)
+}
+
+// Phased fights with the chooser for the phase a boss is in, and like the
+// fallback in phases without one or for actors without phases.
+type Phased struct {
+	Phases   map[string]Chooser
+	Fallback Chooser
+}
+
+func (phased Phased) Choose(actor Actor, allies []Actor, opponents []Actor) Choice {
+	if boss, ok := actor.(interface{ Phase() enemy.Phase }); ok {
+		if chooser, ok := phased.Phases[boss.Phase().Name]; ok {
+			return chooser.Choose(actor, allies, opponents)
+		}
+	}
+
+	return fallback(phased.Fallback).Choose(actor, allies, opponents)
+}
+
+var strategies = map[enemy.Kind]Strategy{
+	enemy.Goblin: func(random dice.Random) Chooser {
+		return Cowardly{Threshold: 25, Chance: 50, Random: random, Fallback: Aggressive{Random: random}}
+	},
+	enemy.Orc: func(random dice.Random) Chooser {
+		return Aggressive{Random: random}
+	},
+	enemy.Troll: func(random dice.Random) Chooser {
+		return Defensive{Threshold: 40, Fallback: Aggressive{Random: random}}
+	},
+	enemy.Dragon: func(random dice.Random) Chooser {
+		return Phased{
+			Phases: map[string]Chooser{
+				"Cornered": Defensive{Threshold: 100, Fallback: Aggressive{Random: random}},
+			},
+			Fallback: Aggressive{Random: random},
+		}
+	},
+}
+
+// RegisterStrategy assigns the strategy enemies of the kind fight with,
+// replacing any strategy the kind had.
+func RegisterStrategy(kind enemy.Kind, strategy Strategy) {
+	strategies[kind] = strategy
+}
+
+// StrategyFor builds the chooser for enemies of the kind, falling back to an
+// aggressive one for kinds without a strategy.
+func StrategyFor(kind enemy.Kind, random dice.Random) Chooser {
+	strategy, ok := strategies[kind]
+	if !ok {
+		return Aggressive{Random: random}
+	}
+
+	return strategy(random)
+}
+
+func fallback(chooser Chooser) Chooser {
+	if chooser == nil {
+		return BasicAttack{}
+	}
+
+	return chooser
+}
+
+func living(actors []Actor) []Actor {
+	standing := make([]Actor, 0, len(actors))
+	for _, actor := range actors {
+		if actor != nil && actor.Health() > 0 {
+			standing = append(standing, actor)
+		}
+	}
+
+	return standing
+}
+
+// wounded reports whether the actor's life is below the threshold percentage
+// of its maximum.
+func wounded(actor Actor, threshold int) bool {
+	return actor.Health()*100 < actor.MaxHealth()*threshold
+}
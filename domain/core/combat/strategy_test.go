@@ -0,0 +1,169 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggressive(t *testing.T) {
+	t.Run("attacks a random opponent still standing", func(t *testing.T) {
+		actor := enemy.New(enemy.Goblin)
+		first, second, fallen := player.New("Elmster"), player.New("Drizzt"), player.New("Bruenor")
+		fallen.Life.Value = 0
+
+		choice := combat.Aggressive{Random: dicetest.Rolls(1)}.Choose(actor, nil, []combat.Actor{fallen, first, second})
+
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{second}}, choice)
+	})
+
+	t.Run("uses its hardest hitting ability when ready", func(t *testing.T) {
+		actor := enemy.New(enemy.Orc)
+		target := player.New("Elmster")
+
+		choice := combat.Aggressive{}.Choose(actor, nil, []combat.Actor{target})
+		require.Equal(t, combat.Choice{Ability: ability.PowerStrike.ID, Targets: []combat.Actor{target}}, choice)
+
+		_, err := actor.Use(ability.PowerStrike.ID)
+		require.NoError(t, err)
+
+		choice = combat.Aggressive{}.Choose(actor, nil, []combat.Actor{target})
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{target}}, choice, "falls back to a basic attack on cooldown")
+	})
+
+	t.Run("has nobody to attack once every opponent is down", func(t *testing.T) {
+		target := player.New("Elmster")
+		target.Life.Value = 0
+
+		require.Equal(t, combat.Choice{}, combat.Aggressive{}.Choose(enemy.New(enemy.Orc), nil, []combat.Actor{target}))
+	})
+}
+
+func TestDefensive(t *testing.T) {
+	strategy := combat.Defensive{Threshold: 40}
+	target := player.New("Elmster")
+
+	t.Run("attacks while healthy", func(t *testing.T) {
+		actor := enemy.New(enemy.Troll)
+
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{target}}, strategy.Choose(actor, nil, []combat.Actor{target}))
+	})
+
+	t.Run("protects itself when low", func(t *testing.T) {
+		actor := enemy.New(enemy.Troll)
+		actor.Life.Value = 30
+
+		require.Equal(t, combat.Choice{Ability: ability.Heal.ID}, strategy.Choose(actor, nil, []combat.Actor{target}))
+
+		_, err := actor.Use(ability.Heal.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, combat.Choice{Ability: ability.Defend.ID}, strategy.Choose(actor, nil, []combat.Actor{target}))
+	})
+}
+
+func TestCowardly(t *testing.T) {
+	target := player.New("Elmster")
+
+	t.Run("flees when low and the roll succeeds", func(t *testing.T) {
+		actor := enemy.New(enemy.Goblin)
+		actor.Life.Value = 20
+		strategy := combat.Cowardly{Threshold: 25, Chance: 50, Random: dicetest.Rolls(49)}
+
+		require.Equal(t, combat.Choice{Flee: true}, strategy.Choose(actor, nil, []combat.Actor{target}))
+	})
+
+	t.Run("stands its ground when the roll fails", func(t *testing.T) {
+		actor := enemy.New(enemy.Goblin)
+		actor.Life.Value = 20
+		strategy := combat.Cowardly{Threshold: 25, Chance: 50, Random: dicetest.Rolls(50)}
+
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{target}}, strategy.Choose(actor, nil, []combat.Actor{target}))
+	})
+
+	t.Run("fights while healthy", func(t *testing.T) {
+		strategy := combat.Cowardly{Threshold: 25, Chance: 100}
+
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{target}}, strategy.Choose(enemy.New(enemy.Goblin), nil, []combat.Actor{target}))
+	})
+
+	t.Run("leaves the encounter", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		actor := enemy.New(enemy.Goblin)
+		actor.Life.Value = 20
+
+		hits, err := encounter.TakeTurn(actor, combat.Cowardly{Threshold: 25}, nil, []combat.Actor{target})
+
+		require.NoError(t, err)
+		require.Empty(t, hits)
+		require.True(t, encounter.Fled(actor))
+		require.False(t, encounter.Fled(target))
+		require.Equal(t, []event.Kind{event.ActorFled}, kinds(subject.notifyCalls))
+	})
+}
+
+func TestSupport(t *testing.T) {
+	target := player.New("Elmster")
+
+	t.Run("heals the most wounded ally", func(t *testing.T) {
+		subject := &MockSubject{}
+		encounter := combat.NewEncounter("cave", subject, dicetest.Rolls())
+		actor := enemy.New(enemy.Goblin)
+		actor.Learn(ability.Mend)
+		scratched, bleeding := enemy.New(enemy.Orc), enemy.New(enemy.Troll)
+		scratched.Life.Value = 40
+		bleeding.Life.Value = 10
+
+		allies := []combat.Actor{scratched, bleeding}
+		choice := combat.Support{Threshold: 50}.Choose(actor, allies, []combat.Actor{target})
+		require.Equal(t, combat.Choice{Ability: ability.Mend.ID, Targets: []combat.Actor{bleeding}}, choice)
+
+		_, err := encounter.TakeTurn(actor, choice, allies, []combat.Actor{target})
+
+		require.NoError(t, err)
+		require.Equal(t, 35, bleeding.Health())
+		require.Equal(t, event.Combat{
+			Kind:      event.ActorHealed,
+			Encounter: "cave",
+			Turn:      1,
+			Actor:     "Goblin",
+			Target:    "Troll",
+			Ability:   "mend",
+			Healed:    25,
+			Life:      35,
+		}, subject.notifyCalls[1])
+	})
+
+	t.Run("attacks when nobody needs healing", func(t *testing.T) {
+		actor := enemy.New(enemy.Goblin)
+		actor.Learn(ability.Mend)
+
+		choice := combat.Support{Threshold: 50}.Choose(actor, []combat.Actor{enemy.New(enemy.Orc)}, []combat.Actor{target})
+
+		require.Equal(t, combat.Choice{Targets: []combat.Actor{target}}, choice)
+	})
+}
+
+func TestStrategyFor(t *testing.T) {
+	t.Run("assigns a strategy per kind", func(t *testing.T) {
+		random := dicetest.Rolls()
+
+		require.Equal(t, combat.Aggressive{Random: random}, combat.StrategyFor(enemy.Orc, random))
+		require.Equal(t, combat.Defensive{Threshold: 40, Fallback: combat.Aggressive{Random: random}}, combat.StrategyFor(enemy.Troll, random))
+		require.Equal(t, combat.Aggressive{Random: random}, combat.StrategyFor("Hydra", random), "unknown kinds are aggressive")
+	})
+
+	t.Run("can be reassigned", func(t *testing.T) {
+		combat.RegisterStrategy("Wyvern", func(dice.Random) combat.Chooser { return combat.Support{Threshold: 50} })
+
+		require.Equal(t, combat.Support{Threshold: 50}, combat.StrategyFor("Wyvern", nil))
+	})
+}
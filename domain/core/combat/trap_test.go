@@ -0,0 +1,82 @@
+package combat_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncounterSpring(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the trap hits", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("hall", subject, dicetest.Rolls(0, 0, 3))
+			target := player.New("Elmster")
+			target.Armour.Value = 2
+			target.Resistances = element.Resistances{element.Poison: 50}
+			sprung := trap.New(trap.PoisonDart)
+
+			hit, err := encounter.Spring(sprung, target)
+
+			require.NoError(t, err)
+			require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Poison, Roll: 8, Mitigated: 4, Damage: 4}, hit, "armour ignores poison but resistances count")
+			require.True(t, sprung.Sprung)
+			require.False(t, sprung.Armed())
+			require.True(t, target.Effects.Has(effect.Poison))
+			require.Equal(t, []event.Kind{event.TrapTriggered, event.EffectApplied}, kinds(subject.notifyCalls))
+			require.Equal(t, "Poison dart", subject.notifyCalls[0].(event.Combat).Actor)
+		})
+
+		t.Run("when the target dodges", func(t *testing.T) {
+			encounter := combat.NewEncounter("hall", &MockSubject{}, dicetest.Rolls(0, 99))
+			target := player.New("Elmster")
+
+			hit, err := encounter.Spring(trap.New(trap.SpikePit), target)
+
+			require.NoError(t, err)
+			require.Equal(t, internal.OutcomeDodge, hit.Outcome)
+			require.False(t, target.Effects.Has(effect.Bleed))
+		})
+
+		t.Run("when the trap kills", func(t *testing.T) {
+			subject := &MockSubject{}
+			encounter := combat.NewEncounter("hall", subject, dicetest.Rolls())
+			target := player.New("Elmster")
+			target.Life.Value = 1
+
+			_, err := encounter.Spring(trap.New(trap.CollapsingFloor), target)
+
+			require.NoError(t, err)
+			require.Equal(t, []event.Kind{event.TrapTriggered, event.ActorDied}, kinds(subject.notifyCalls))
+			require.Equal(t, "Collapsing floor", subject.notifyCalls[1].(event.Combat).Killer)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the trap is not armed", func(t *testing.T) {
+			encounter := combat.NewEncounter("hall", &MockSubject{}, dicetest.Rolls())
+			disarmed := trap.New(trap.SpikePit)
+			disarmed.Disarmed = true
+
+			_, err := encounter.Spring(disarmed, player.New("Elmster"))
+
+			require.EqualError(t, err, "trap is not armed")
+		})
+
+		t.Run("when the target is nil", func(t *testing.T) {
+			encounter := combat.NewEncounter("hall", &MockSubject{}, dicetest.Rolls())
+
+			_, err := encounter.Spring(trap.New(trap.SpikePit), nil)
+
+			require.EqualError(t, err, "trap and target cannot be nil")
+		})
+	})
+}
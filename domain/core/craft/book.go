@@ -0,0 +1,84 @@
+package craft
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+)
+
+// Book holds every recipe and which of them the player has discovered. A
+// recipe is discovered the first time the player carries at least one of each
+// of its ingredients, and only discovered recipes can be crafted.
+type Book struct {
+	recipes []Recipe
+	known   map[string]bool
+}
+
+var unknownRecipe = errors.New("unknown recipe")
+
+// NewBook creates a book of the recipes with none of them discovered.
+func NewBook(recipes ...Recipe) *Book {
+	return &Book{recipes: recipes, known: map[string]bool{}}
+}
+
+// Discover learns every recipe whose ingredients all show up among the
+// belongings, returning those that were not known before.
+func (book *Book) Discover(belongings []item.Item) []Recipe {
+	var discovered []Recipe
+	for _, recipe := range book.recipes {
+		key := strings.ToLower(recipe.Name)
+		if book.known[key] || !hinted(recipe, belongings) {
+			continue
+		}
+
+		book.known[key] = true
+		discovered = append(discovered, recipe)
+	}
+
+	return discovered
+}
+
+// Known lists the discovered recipes in the order the book holds them.
+func (book *Book) Known() []Recipe {
+	var known []Recipe
+	for _, recipe := range book.recipes {
+		if book.known[strings.ToLower(recipe.Name)] {
+			known = append(known, recipe)
+		}
+	}
+
+	return known
+}
+
+// Lookup finds the discovered recipe with the name, ignoring case.
+func (book *Book) Lookup(name string) (Recipe, error) {
+	index := slices.IndexFunc(book.recipes, func(recipe Recipe) bool { return strings.EqualFold(recipe.Name, name) })
+	if index < 0 || !book.known[strings.ToLower(book.recipes[index].Name)] {
+		return Recipe{}, unknownRecipe
+	}
+
+	return book.recipes[index], nil
+}
+
+// Craft crafts the named recipe from what the player carries, discovering
+// whatever their belongings hint at first.
+func (book *Book) Craft(crafter *player.Player, name string) (item.Item, error) {
+	book.Discover(crafter.Belongings())
+
+	recipe, err := book.Lookup(name)
+	if err != nil {
+		return item.Item{}, err
+	}
+
+	return recipe.Craft(crafter)
+}
+
+// hinted reports whether the belongings hold one of each ingredient.
+func hinted(recipe Recipe, belongings []item.Item) bool {
+	return !slices.ContainsFunc(recipe.Ingredients, func(ingredient Ingredient) bool {
+		return !slices.ContainsFunc(belongings, func(held item.Item) bool { return held.Called(ingredient.Item) })
+	})
+}
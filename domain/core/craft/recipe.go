@@ -0,0 +1,82 @@
+package craft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+)
+
+// Ingredient is an item a recipe uses up, by name, and how many of it.
+type Ingredient struct {
+	Item  string
+	Count int
+}
+
+func (ingredient Ingredient) String() string {
+	return fmt.Sprintf("%d %s", ingredient.Count, ingredient.Item)
+}
+
+// Recipe turns its ingredients from the player's inventory into its result,
+// such as two potions into a greater potion.
+type Recipe struct {
+	Name        string
+	Ingredients []Ingredient
+	Result      item.Item
+}
+
+// Missing lists how many of each ingredient the belongings lack, ignoring the
+// case of their names.
+func (recipe Recipe) Missing(belongings []item.Item) []Ingredient {
+	var missing []Ingredient
+	for _, ingredient := range recipe.Ingredients {
+		carried := 0
+		for _, held := range belongings {
+			if held.Called(ingredient.Item) {
+				carried++
+			}
+		}
+
+		if carried < ingredient.Count {
+			missing = append(missing, Ingredient{Item: ingredient.Item, Count: ingredient.Count - carried})
+		}
+	}
+
+	return missing
+}
+
+// Craft uses the ingredients up from what the player carries, the inventory
+// before the equipment, and hands them a fresh instance of the result.
+func (recipe Recipe) Craft(crafter *player.Player) (item.Item, error) {
+	if missing := recipe.Missing(crafter.Belongings()); len(missing) > 0 {
+		return item.Item{}, fmt.Errorf("missing %s", list(missing))
+	}
+
+	for _, ingredient := range recipe.Ingredients {
+		for range ingredient.Count {
+			if _, err := crafter.UseUp(ingredient.Item); err != nil {
+				return item.Item{}, err
+			}
+		}
+	}
+
+	crafted := recipe.Result.Clone()
+	crafter.Collect(crafted)
+
+	return crafted, nil
+}
+
+// list joins the ingredients for a message, such as "1 Potion and 2 Bones".
+func list(ingredients []Ingredient) string {
+	names := make([]string, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		names = append(names, ingredient.String())
+	}
+
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
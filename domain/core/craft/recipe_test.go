@@ -0,0 +1,99 @@
+package craft_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/craft"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	potion     = item.Item{ID: "potion", Name: "Potion", Type: item.Potion}
+	sword      = item.Item{ID: "sword", Name: "Sword", Type: item.Weapon, Power: 5, Durability: 40}
+	gem        = item.Item{ID: "fire-gem", Name: "Fire gem", Type: item.Material, Element: "fire"}
+	greater    = craft.Recipe{Name: "Greater potion", Ingredients: []craft.Ingredient{{Item: "Potion", Count: 2}}, Result: item.Item{ID: "greater-potion", Name: "Greater potion", Type: item.Potion}}
+	flameSword = craft.Recipe{Name: "Flame sword", Ingredients: []craft.Ingredient{{Item: "Sword", Count: 1}, {Item: "Fire gem", Count: 1}}, Result: item.Item{ID: "flame-sword", Name: "Flame sword", Type: item.Weapon, Element: "fire", Durability: 50}}
+)
+
+func TestRecipe_Craft(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the inventory holds every ingredient", func(t *testing.T) {
+			p := player.New("Elmster")
+			p.Collect(potion.Clone(), gem.Clone(), potion.Clone(), potion.Clone())
+
+			crafted, err := greater.Craft(p)
+
+			require.NoError(t, err)
+			require.Equal(t, "Greater potion", crafted.Name)
+			require.NotNil(t, crafted.Instance)
+			require.Len(t, p.Inventory, 3)
+			require.Equal(t, []string{"Fire gem", "Potion", "Greater potion"}, []string{p.Inventory[0].Name, p.Inventory[1].Name, p.Inventory[2].Name})
+		})
+
+		t.Run("when an ingredient is equipped", func(t *testing.T) {
+			p := player.New("Elmster")
+			blade := sword.Clone()
+			p.Collect(blade, gem.Clone())
+			require.NoError(t, p.Equip(blade))
+
+			crafted, err := flameSword.Craft(p)
+
+			require.NoError(t, err)
+			require.Equal(t, 50, crafted.Instance.Durability)
+			require.Empty(t, p.Equipment)
+			require.Equal(t, []item.Item{crafted}, p.Inventory)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when ingredients are missing, using none of them up", func(t *testing.T) {
+			p := player.New("Elmster")
+			p.Collect(potion.Clone())
+
+			_, err := greater.Craft(p)
+			require.EqualError(t, err, "missing 1 Potion")
+
+			_, err = flameSword.Craft(p)
+			require.EqualError(t, err, "missing 1 Sword and 1 Fire gem")
+			require.Len(t, p.Inventory, 1)
+		})
+	})
+}
+
+func TestBook(t *testing.T) {
+	t.Run("discovers recipes once one of each ingredient is carried", func(t *testing.T) {
+		book := craft.NewBook(flameSword, greater)
+
+		require.Empty(t, book.Discover([]item.Item{sword}))
+
+		discovered := book.Discover([]item.Item{sword, gem, potion})
+		require.Equal(t, []string{"Flame sword", "Greater potion"}, []string{discovered[0].Name, discovered[1].Name})
+		require.Empty(t, book.Discover([]item.Item{sword, gem, potion}))
+		require.Len(t, book.Known(), 2)
+	})
+
+	t.Run("crafts a recipe the player's belongings reveal", func(t *testing.T) {
+		book := craft.NewBook(greater)
+		p := player.New("Elmster")
+		p.Collect(potion.Clone(), potion.Clone())
+
+		crafted, err := book.Craft(p, "greater potion")
+
+		require.NoError(t, err)
+		require.Equal(t, "Greater potion", crafted.Name)
+	})
+
+	t.Run("refuses recipes that are not discovered", func(t *testing.T) {
+		book := craft.NewBook(greater, flameSword)
+		p := player.New("Elmster")
+		p.Collect(potion.Clone())
+
+		_, err := book.Craft(p, "Flame sword")
+		require.EqualError(t, err, "unknown recipe")
+
+		_, err = book.Lookup("Elixir")
+		require.EqualError(t, err, "unknown recipe")
+	})
+}
@@ -0,0 +1,7 @@
+// Package dice holds the source of luck every roll in the game draws from.
+package dice
+
+// Random is where a roll draws its luck from, such as a seeded *rand.Rand.
+type Random interface {
+	Intn(n int) int
+}
@@ -0,0 +1,86 @@
+package effect
+
+import "github.com/pedrokunz/go-design-patterns/domain/core/element"
+
+type Kind string
+
+const (
+	Poison       Kind = "poison"
+	Stun         Kind = "stun"
+	Bleed        Kind = "bleed"
+	Regeneration Kind = "regeneration"
+	Shield       Kind = "shield"
+)
+
+// Stacking decides what happens when an effect is applied to someone who is
+// already under an effect of the same kind.
+type Stacking string
+
+const (
+	// StackRefresh keeps a single instance, renewing its duration and keeping
+	// the strongest magnitude.
+	StackRefresh Stacking = "refresh"
+	// StackIntensity keeps a single instance whose magnitude is multiplied by
+	// the number of stacks, up to MaxStacks.
+	StackIntensity Stacking = "intensity"
+	// StackIndependent keeps every application as its own instance.
+	StackIndependent Stacking = "independent"
+)
+
+// Phase is when in its owner's turn an effect takes hold.
+type Phase string
+
+const (
+	TurnStart Phase = "turn_start"
+	TurnEnd   Phase = "turn_end"
+)
+
+// Effect is a status lasting Duration of its owner's turns. Poison and bleed
+// deal Magnitude damage, regeneration heals it and shield adds it to the
+// armour; stun makes its owner skip turns.
+type Effect struct {
+	Kind      Kind
+	Duration  int
+	Magnitude int
+	Stacks    int
+	MaxStacks int
+	Stacking  Stacking
+	Phase     Phase
+	Source    string
+}
+
+var definitions = map[Kind]Effect{
+	Poison:       {Kind: Poison, Duration: 3, Magnitude: 4, MaxStacks: 5, Stacking: StackIntensity, Phase: TurnStart},
+	Stun:         {Kind: Stun, Duration: 1, Stacking: StackRefresh, Phase: TurnStart},
+	Bleed:        {Kind: Bleed, Duration: 2, Magnitude: 5, Stacking: StackIndependent, Phase: TurnEnd},
+	Regeneration: {Kind: Regeneration, Duration: 3, Magnitude: 8, Stacking: StackRefresh, Phase: TurnEnd},
+	Shield:       {Kind: Shield, Duration: 2, Magnitude: 10, Stacking: StackRefresh, Phase: TurnStart},
+}
+
+// New returns the default definition of an effect applied by source, or an
+// effect with no duration when the kind is unknown.
+func New(kind Kind, source string) Effect {
+	effect, ok := definitions[kind]
+	if !ok {
+		return Effect{Kind: kind, Source: source}
+	}
+
+	effect.Source = source
+
+	return effect
+}
+
+// Element is what the damage the effect deals is made of: poison is poison,
+// anything else, such as bleeding, is physical.
+func (effect Effect) Element() element.Element {
+	if effect.Kind == Poison {
+		return element.Poison
+	}
+
+	return element.Physical
+}
+
+// Amount is how much the effect deals, heals or protects for on each tick.
+func (effect Effect) Amount() int {
+	return effect.Magnitude * max(effect.Stacks, 1)
+}
@@ -0,0 +1,32 @@
+package effect_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("uses the definition of the kind", func(t *testing.T) {
+		actual := effect.New(effect.Poison, "Elmster")
+
+		require.Equal(t, effect.Poison, actual.Kind)
+		require.Equal(t, "Elmster", actual.Source)
+		require.Equal(t, effect.StackIntensity, actual.Stacking)
+		require.Positive(t, actual.Duration)
+	})
+
+	t.Run("has no duration when the kind is unknown", func(t *testing.T) {
+		actual := effect.New("burning", "Elmster")
+
+		require.Equal(t, effect.Effect{Kind: "burning", Source: "Elmster"}, actual)
+	})
+}
+
+func TestEffectAmount(t *testing.T) {
+	t.Run("scales with stacks", func(t *testing.T) {
+		require.Equal(t, 4, effect.Effect{Magnitude: 4}.Amount())
+		require.Equal(t, 12, effect.Effect{Magnitude: 4, Stacks: 3}.Amount())
+	})
+}
@@ -0,0 +1,112 @@
+package effect
+
+import (
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Tick reports what an effect did to its owner during a phase.
+type Tick struct {
+	Effect  Effect
+	Amount  int
+	Expired bool
+}
+
+// Set holds the effects currently active on an actor and the kinds it is
+// immune to.
+type Set struct {
+	Active []Effect
+	Immune []Kind
+}
+
+// Apply adds the effect following its stacking rule, reporting whether it
+// took hold at all.
+func (set *Set) Apply(effect Effect) bool {
+	if effect.Duration <= 0 || slices.Contains(set.Immune, effect.Kind) {
+		return false
+	}
+
+	effect.Stacks = max(effect.Stacks, 1)
+
+	index := slices.IndexFunc(set.Active, func(active Effect) bool { return active.Kind == effect.Kind })
+	if index < 0 || effect.Stacking == StackIndependent {
+		set.Active = append(set.Active, effect)
+		return true
+	}
+
+	active := &set.Active[index]
+	active.Duration = max(active.Duration, effect.Duration)
+	active.Source = effect.Source
+
+	switch effect.Stacking {
+	case StackIntensity:
+		active.Stacks = min(active.Stacks+1, max(active.MaxStacks, 1))
+	default:
+		active.Magnitude = max(active.Magnitude, effect.Magnitude)
+	}
+
+	return true
+}
+
+func (set *Set) Has(kind Kind) bool {
+	return slices.ContainsFunc(set.Active, func(active Effect) bool { return active.Kind == kind })
+}
+
+// Protection is the armour granted by active shields.
+func (set *Set) Protection() (protection int) {
+	for _, active := range set.Active {
+		if active.Kind == Shield {
+			protection += active.Amount()
+		}
+	}
+
+	return protection
+}
+
+// Healer heals an actor, as much as whatever changes their healing allows.
+type Healer interface {
+	Heal(amount int) int
+}
+
+// Tick resolves every effect taking hold in the phase against the owner's
+// life. Damage goes through the owner's resistances to the effect's element,
+// though not their armour, and healing through the healer so changes to the
+// owner's healing apply. The end of the turn also counts down every duration
+// and drops the effects that ran out.
+func (set *Set) Tick(phase Phase, life *internal.Life, resistances element.Resistances, healer Healer) []Tick {
+	ticks := make([]Tick, 0)
+
+	for _, active := range set.Active {
+		if active.Phase != phase {
+			continue
+		}
+
+		switch active.Kind {
+		case Poison, Bleed:
+			hit := life.Suffer(active.Amount(), active.Element(), internal.Armour{}, resistances)
+			ticks = append(ticks, Tick{Effect: active, Amount: hit.Damage})
+		case Regeneration:
+			ticks = append(ticks, Tick{Effect: active, Amount: healer.Heal(active.Amount())})
+		}
+	}
+
+	if phase != TurnEnd {
+		return ticks
+	}
+
+	remaining := make([]Effect, 0, len(set.Active))
+	for _, active := range set.Active {
+		active.Duration--
+		if active.Duration <= 0 {
+			ticks = append(ticks, Tick{Effect: active, Expired: true})
+			continue
+		}
+
+		remaining = append(remaining, active)
+	}
+	set.Active = remaining
+
+	return ticks
+}
@@ -0,0 +1,101 @@
+package effect_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetApply(t *testing.T) {
+	t.Run("stacks intensity up to the maximum", func(t *testing.T) {
+		set := effect.Set{}
+		poison := effect.Effect{Kind: effect.Poison, Duration: 2, Magnitude: 3, MaxStacks: 2, Stacking: effect.StackIntensity}
+
+		require.True(t, set.Apply(poison))
+		require.True(t, set.Apply(poison))
+		require.True(t, set.Apply(poison))
+
+		require.Len(t, set.Active, 1)
+		require.Equal(t, 2, set.Active[0].Stacks)
+		require.Equal(t, 6, set.Active[0].Amount())
+	})
+
+	t.Run("refreshes duration and keeps the strongest magnitude", func(t *testing.T) {
+		set := effect.Set{}
+
+		set.Apply(effect.Effect{Kind: effect.Shield, Duration: 1, Magnitude: 10, Stacking: effect.StackRefresh})
+		set.Apply(effect.Effect{Kind: effect.Shield, Duration: 3, Magnitude: 5, Stacking: effect.StackRefresh})
+
+		require.Len(t, set.Active, 1)
+		require.Equal(t, 3, set.Active[0].Duration)
+		require.Equal(t, 10, set.Protection())
+	})
+
+	t.Run("keeps independent instances apart", func(t *testing.T) {
+		set := effect.Set{}
+
+		set.Apply(effect.New(effect.Bleed, "Goblin"))
+		set.Apply(effect.New(effect.Bleed, "Orc"))
+
+		require.Len(t, set.Active, 2)
+	})
+
+	t.Run("ignores immune kinds and effects without duration", func(t *testing.T) {
+		set := effect.Set{Immune: []effect.Kind{effect.Poison}}
+
+		require.False(t, set.Apply(effect.New(effect.Poison, "Goblin")))
+		require.False(t, set.Apply(effect.New("burning", "Goblin")))
+		require.Empty(t, set.Active)
+	})
+}
+
+func TestSetTick(t *testing.T) {
+	t.Run("damages at the start of the turn", func(t *testing.T) {
+		set := effect.Set{}
+		set.Apply(effect.New(effect.Poison, "Goblin"))
+		set.Apply(effect.New(effect.Regeneration, "Elmster"))
+		life := internal.Life{Value: 50, Max: 100}
+
+		ticks := set.Tick(effect.TurnStart, &life, nil, &life)
+
+		require.Len(t, ticks, 1)
+		require.Equal(t, effect.Poison, ticks[0].Effect.Kind)
+		require.Equal(t, 4, ticks[0].Amount)
+		require.Equal(t, 46, life.Value)
+		require.True(t, set.Has(effect.Regeneration))
+	})
+
+	t.Run("damages through the owner's resistances", func(t *testing.T) {
+		set := effect.Set{}
+		set.Apply(effect.New(effect.Poison, "Goblin"))
+		set.Apply(effect.Effect{Kind: effect.Bleed, Duration: 1, Magnitude: 8, Phase: effect.TurnStart})
+		life := internal.Life{Value: 50, Max: 100}
+
+		ticks := set.Tick(effect.TurnStart, &life, element.Resistances{element.Poison: 50, element.Physical: 25}, &life)
+
+		require.Len(t, ticks, 2)
+		require.Equal(t, 2, ticks[0].Amount)
+		require.Equal(t, 6, ticks[1].Amount)
+		require.Equal(t, 42, life.Value)
+	})
+
+	t.Run("heals and counts down at the end of the turn", func(t *testing.T) {
+		set := effect.Set{}
+		set.Apply(effect.Effect{Kind: effect.Regeneration, Duration: 1, Magnitude: 8, Phase: effect.TurnEnd})
+		set.Apply(effect.New(effect.Stun, "Orc"))
+		life := internal.Life{Value: 95, Max: 100}
+
+		ticks := set.Tick(effect.TurnEnd, &life, nil, &life)
+
+		require.Equal(t, []effect.Tick{
+			{Effect: effect.Effect{Kind: effect.Regeneration, Duration: 1, Magnitude: 8, Stacks: 1, Phase: effect.TurnEnd}, Amount: 5},
+			{Effect: effect.Effect{Kind: effect.Regeneration, Duration: 0, Magnitude: 8, Stacks: 1, Phase: effect.TurnEnd}, Expired: true},
+			{Effect: effect.Effect{Kind: effect.Stun, Duration: 0, Stacks: 1, Stacking: effect.StackRefresh, Phase: effect.TurnStart, Source: "Orc"}, Expired: true},
+		}, ticks)
+		require.Equal(t, 100, life.Value)
+		require.Empty(t, set.Active)
+	})
+}
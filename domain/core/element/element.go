@@ -0,0 +1,38 @@
+package element
+
+import "math"
+
+// Element is the type of damage an attack deals.
+type Element string
+
+const (
+	Physical  Element = "physical"
+	Fire      Element = "fire"
+	Ice       Element = "ice"
+	Poison    Element = "poison"
+	Lightning Element = "lightning"
+)
+
+// Elements lists every element known to the game.
+func Elements() []Element {
+	return []Element{Physical, Fire, Ice, Poison, Lightning}
+}
+
+// OrPhysical treats an unset element as physical.
+func (element Element) OrPhysical() Element {
+	if element == "" {
+		return Physical
+	}
+
+	return element
+}
+
+// Resistances are the percentage of each element's damage an actor shrugs
+// off. Negative values are weaknesses that make the element hurt more.
+type Resistances map[Element]int
+
+// Resist returns how much of the damage is resisted, which is negative when
+// the element is a weakness.
+func (resistances Resistances) Resist(damage int, element Element) int {
+	return int(math.Round(float64(damage) * float64(resistances[element.OrPhysical()]) / 100))
+}
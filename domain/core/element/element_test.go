@@ -0,0 +1,33 @@
+package element_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementOrPhysical(t *testing.T) {
+	t.Run("treats unset elements as physical", func(t *testing.T) {
+		require.Equal(t, element.Physical, element.Element("").OrPhysical())
+		require.Equal(t, element.Fire, element.Fire.OrPhysical())
+	})
+}
+
+func TestResistancesResist(t *testing.T) {
+	resistances := element.Resistances{element.Fire: -50, element.Poison: 25, element.Physical: 10}
+
+	t.Run("resists a share of the damage", func(t *testing.T) {
+		require.Equal(t, 10, resistances.Resist(40, element.Poison))
+		require.Equal(t, 4, resistances.Resist(40, ""))
+	})
+
+	t.Run("amplifies weaknesses", func(t *testing.T) {
+		require.Equal(t, -20, resistances.Resist(40, element.Fire))
+	})
+
+	t.Run("resists nothing without a resistance", func(t *testing.T) {
+		require.Zero(t, resistances.Resist(40, element.Ice))
+		require.Zero(t, element.Resistances(nil).Resist(40, element.Ice))
+	})
+}
@@ -0,0 +1,130 @@
+package enemy
+
+import (
+	"errors"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Phase is a stage of a boss fight. It begins once the boss's life falls to
+// Threshold percent of its maximum and replaces the boss's armour, attack and
+// abilities with its own.
+type Phase struct {
+	Name      string
+	Threshold int
+	Armour    int
+	Attack    internal.Attack
+	Abilities []ability.Ability
+}
+
+// Boss is an enemy that fights through its phases in order as it loses life.
+type Boss struct {
+	*Enemy
+	Phases  []Phase
+	current int
+}
+
+var bosses = map[Kind][]Phase{
+	Dragon: {
+		{
+			Name:      "Awakened",
+			Threshold: 100,
+			Armour:    10,
+			Attack:    internal.Attack{Min: 10, Max: 30},
+			Abilities: []ability.Ability{ability.PowerStrike},
+		},
+		{
+			Name:      "Enraged",
+			Threshold: 60,
+			Armour:    5,
+			Attack:    internal.Attack{Min: 20, Max: 40},
+			Abilities: []ability.Ability{ability.PowerStrike, ability.AreaAttack},
+		},
+		{
+			Name:      "Cornered",
+			Threshold: 25,
+			Armour:    15,
+			Attack:    internal.Attack{Min: 15, Max: 35},
+			Abilities: []ability.Ability{ability.Heal, ability.Defend},
+		},
+	},
+}
+
+// RegisterBoss sets the phases bosses of the kind fight through, replacing any
+// phases the kind had.
+func RegisterBoss(t Kind, phases []Phase) error {
+	if len(phases) == 0 {
+		return errors.New("boss needs at least one phase")
+	}
+
+	bosses[t] = phases
+
+	return nil
+}
+
+// NewBoss creates a boss of the kind, three times as tough as a regular enemy
+// and starting in its first phase.
+func NewBoss(t Kind) *Boss {
+	b := &Boss{Enemy: New(t), Phases: bosses[t]}
+	b.Life = internal.Life{Value: 300, Max: 300}
+	b.Resource = internal.Resource{Value: 60, Max: 60, Regen: 10}
+
+	if len(b.Phases) > 0 {
+		b.enter(0)
+	}
+
+	return b
+}
+
+// Phase is the phase the boss is fighting in.
+func (b *Boss) Phase() Phase {
+	if len(b.Phases) == 0 {
+		return Phase{}
+	}
+
+	return b.Phases[b.current]
+}
+
+// Stage is the index of the phase the boss is fighting in.
+func (b *Boss) Stage() int {
+	return b.current
+}
+
+// Rewind puts the boss back in the phase at the index, such as when the game
+// is rolled back to a checkpoint. Indexes of phases the boss lacks are ignored.
+func (b *Boss) Rewind(stage int) {
+	if stage >= 0 && stage < len(b.Phases) {
+		b.enter(stage)
+	}
+}
+
+// Advance moves the boss into its next phase once its life has fallen to
+// that phase's threshold, reporting whether it did. Bosses that lose a lot of
+// life at once advance one phase per call.
+func (b *Boss) Advance() (Phase, bool) {
+	next := b.current + 1
+	if b.Health() <= 0 || next >= len(b.Phases) {
+		return Phase{}, false
+	}
+
+	if b.Health()*100 > b.Life.Max*b.Phases[next].Threshold {
+		return Phase{}, false
+	}
+
+	b.enter(next)
+
+	return b.Phases[next], true
+}
+
+func (b *Boss) enter(index int) {
+	phase := b.Phases[index]
+
+	b.current = index
+	b.Armour.Value = phase.Armour
+	b.Attack = phase.Attack
+	b.Known = nil
+	for _, known := range phase.Abilities {
+		b.Learn(known)
+	}
+}
@@ -0,0 +1,80 @@
+package enemy_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoss(t *testing.T) {
+	t.Run("starts in its first phase", func(t *testing.T) {
+		actual := enemy.NewBoss(enemy.Dragon)
+
+		require.Equal(t, "Awakened", actual.Phase().Name)
+		require.Equal(t, 300, actual.Health())
+		require.Equal(t, internal.Attack{Min: 10, Max: 30}, actual.Offense())
+		require.Equal(t, []ability.Ability{ability.PowerStrike}, actual.Abilities())
+	})
+
+	t.Run("advances once its life falls to the next threshold", func(t *testing.T) {
+		actual := enemy.NewBoss(enemy.Dragon)
+		actual.Life.Value = 181
+
+		_, changed := actual.Advance()
+		require.False(t, changed)
+
+		actual.Life.Value = 180
+		phase, changed := actual.Advance()
+
+		require.True(t, changed)
+		require.Equal(t, "Enraged", phase.Name)
+		require.Equal(t, phase, actual.Phase())
+		require.Equal(t, 5, actual.Armour.Value)
+		require.Equal(t, []ability.Ability{ability.PowerStrike, ability.AreaAttack}, actual.Abilities())
+	})
+
+	t.Run("advances one phase at a time", func(t *testing.T) {
+		actual := enemy.NewBoss(enemy.Dragon)
+		actual.Life.Value = 10
+
+		first, _ := actual.Advance()
+		second, _ := actual.Advance()
+		_, changed := actual.Advance()
+
+		require.Equal(t, "Enraged", first.Name)
+		require.Equal(t, "Cornered", second.Name)
+		require.False(t, changed, "there is no phase after the last")
+	})
+
+	t.Run("stays put once defeated", func(t *testing.T) {
+		actual := enemy.NewBoss(enemy.Dragon)
+		actual.Life.Value = 0
+
+		_, changed := actual.Advance()
+
+		require.False(t, changed)
+		require.Equal(t, "Awakened", actual.Phase().Name)
+	})
+}
+
+func TestRegisterBoss(t *testing.T) {
+	t.Cleanup(enemy.Snapshot())
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the boss has phases", func(t *testing.T) {
+			err := enemy.RegisterBoss("Lich", []enemy.Phase{{Name: "Risen", Threshold: 100, Attack: internal.Attack{Min: 5, Max: 5}}})
+
+			require.NoError(t, err)
+			require.Equal(t, "Risen", enemy.NewBoss("Lich").Phase().Name)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the boss has no phases", func(t *testing.T) {
+			require.EqualError(t, enemy.RegisterBoss("Lich", nil), "boss needs at least one phase")
+		})
+	})
+}
@@ -1,30 +1,134 @@
 package enemy
 
 import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
 	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
-	"math/rand"
 )
 
 type Enemy struct {
-	Type   Kind
-	Armour internal.Armour
-	Life   internal.Life
-	Attack internal.Attack
+	Type        Kind
+	Armour      internal.Armour
+	Life        internal.Life
+	Attack      internal.Attack
+	Precision   internal.Precision
+	Speed       int
+	Resistances element.Resistances
+	Effects     effect.Set
+	ability.Kit
+}
+
+var abilities = map[Kind][]ability.Ability{
+	Goblin: {ability.PoisonStrike},
+	Orc:    {ability.PowerStrike},
+	Troll:  {ability.Heal, ability.Defend},
+}
+
+var resistances = map[Kind]element.Resistances{
+	Goblin: {element.Poison: 50},
+	Orc:    {element.Physical: 10, element.Ice: 25},
+	Troll:  {element.Fire: -50, element.Poison: 25},
+	Dragon: {element.Fire: 75, element.Ice: -25},
+}
+
+var immunities = map[Kind][]effect.Kind{
+	Troll: {effect.Bleed},
 }
 
 func New(t Kind) *Enemy {
-	return &Enemy{
+	e := &Enemy{
 		Type:   t,
 		Armour: internal.Armour{Value: 0},
 		Attack: internal.Attack{Min: 1, Max: 100},
-		Life:   internal.Life{Value: 100},
+		Life:   internal.Life{Value: 100, Max: 100},
+		Precision: internal.Precision{
+			Accuracy:       85,
+			Evasion:        5,
+			CritChance:     5,
+			CritMultiplier: 1.5,
+		},
+		Speed:       10,
+		Resistances: resistances[t],
+		Effects:     effect.Set{Immune: immunities[t]},
+		Kit: ability.Kit{
+			Resource: internal.Resource{Value: 40, Max: 40, Regen: 5},
+		},
+	}
+
+	if shape, ok := bodies[t]; ok {
+		e.Life = shape.life
+		e.Armour = shape.armour
+		e.Attack = shape.attack
+	}
+
+	for _, known := range abilities[t] {
+		e.Learn(known)
 	}
+
+	return e
+}
+
+func (e *Enemy) String() string {
+	return string(e.Type)
+}
+
+func (e *Enemy) Offense() internal.Attack {
+	return e.Attack
+}
+
+func (e *Enemy) Odds() internal.Precision {
+	return e.Precision
+}
+
+func (e *Enemy) Health() int {
+	return e.Life.Value
+}
+
+func (e *Enemy) MaxHealth() int {
+	return e.Life.Max
+}
+
+func (e *Enemy) Heal(amount int) int {
+	return e.Life.Heal(amount)
+}
+
+func (e *Enemy) Guard(amount int) {
+	e.Armour.Bonus += amount
+}
+
+// StartTurn drops any guard from the previous turn, recovers the resource pool
+// and moves every cooldown one turn closer to ready.
+func (e *Enemy) StartTurn() {
+	e.Armour.Bonus = 0
+	e.Kit.Tick()
 }
 
 func (e *Enemy) TakeDamage(attack internal.Attack) int {
-	damage := rand.Intn(attack.Max-attack.Min) + attack.Min
+	return e.TakeHit(attack).Roll
+}
+
+func (e *Enemy) TakeHit(attack internal.Attack) internal.Hit {
+	return e.Receive(attack.Roll(), attack.Element)
+}
 
-	e.Life.Value -= damage - e.Armour.Value
+// Receive suffers a rolled attack through the resistances, the armour and any
+// active shields.
+func (e *Enemy) Receive(roll int, damage element.Element) internal.Hit {
+	armour := e.Armour
+	armour.Bonus += e.Effects.Protection()
+
+	return e.Life.Suffer(roll, damage, armour, e.Resistances)
+}
+
+func (e *Enemy) ApplyEffect(applied effect.Effect) bool {
+	return e.Effects.Apply(applied)
+}
+
+func (e *Enemy) TickEffects(phase effect.Phase) []effect.Tick {
+	return e.Effects.Tick(phase, &e.Life, e.Resistances, e)
+}
 
-	return damage
+func (e *Enemy) Stunned() bool {
+	return e.Effects.Has(effect.Stun)
 }
@@ -4,6 +4,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"testing"
 
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
 	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
 	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
 )
@@ -15,9 +18,93 @@ func TestEnemy(t *testing.T) {
 			Type:   enemy.Goblin,
 			Armour: internal.Armour{Value: 0},
 			Attack: internal.Attack{Min: 1, Max: 100},
-			Life:   internal.Life{Value: 100},
+			Life:   internal.Life{Value: 100, Max: 100},
+			Precision: internal.Precision{
+				Accuracy:       85,
+				Evasion:        5,
+				CritChance:     5,
+				CritMultiplier: 1.5,
+			},
+			Speed:       10,
+			Resistances: element.Resistances{element.Poison: 50},
+			Kit: ability.Kit{
+				Resource: internal.Resource{Value: 40, Max: 40, Regen: 5},
+				Known:    []ability.Ability{ability.PoisonStrike},
+			},
 		}
 
 		require.Equal(t, actual, expected, "actual %v, expected %v", actual, expected)
 	})
+
+	t.Run("knows the abilities of its kind", func(t *testing.T) {
+		require.Equal(t, []ability.Ability{ability.PowerStrike}, enemy.New(enemy.Orc).Abilities())
+		require.Equal(t, []effect.Kind{effect.Bleed}, enemy.New(enemy.Troll).Effects.Immune)
+		require.Equal(t, []ability.Ability{ability.Heal, ability.Defend}, enemy.New(enemy.Troll).Abilities())
+	})
+}
+
+func TestEnemyTakeHit(t *testing.T) {
+	t.Run("takes damage reduced by armour", func(t *testing.T) {
+		actual := enemy.New(enemy.Troll)
+		actual.Armour.Value = 2
+
+		hit := actual.TakeHit(internal.Attack{Min: 10, Max: 11})
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 10, Mitigated: 2, Damage: 8}, hit)
+		require.Equal(t, 92, actual.Health())
+		require.Equal(t, "Troll", actual.String())
+		require.Equal(t, actual.Attack, actual.Offense())
+	})
+
+	t.Run("burns trolls for extra damage", func(t *testing.T) {
+		actual := enemy.New(enemy.Troll)
+		actual.Armour.Value = 2
+
+		hit := actual.TakeHit(internal.Attack{Min: 10, Max: 10, Element: element.Fire})
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Fire, Roll: 10, Mitigated: -5, Damage: 15}, hit)
+		require.Equal(t, 85, actual.Health())
+	})
+
+	t.Run("reports the rolled damage", func(t *testing.T) {
+		actual := enemy.New(enemy.Troll)
+
+		damage := actual.TakeDamage(internal.Attack{Min: 10, Max: 11})
+
+		require.Equal(t, 10, damage)
+		require.Equal(t, 90, actual.Health())
+	})
+}
+
+func TestEnemyTurn(t *testing.T) {
+	t.Run("heals up to the maximum life", func(t *testing.T) {
+		actual := enemy.New(enemy.Troll)
+		actual.Life.Value = 80
+
+		require.Equal(t, 20, actual.Heal(30))
+		require.Equal(t, 100, actual.Health())
+	})
+
+	t.Run("guards until the next turn", func(t *testing.T) {
+		actual := enemy.New(enemy.Troll)
+
+		actual.Guard(20)
+		hit := actual.TakeHit(internal.Attack{Min: 10, Max: 11})
+		require.Zero(t, hit.Damage)
+
+		actual.StartTurn()
+		require.Zero(t, actual.Armour.Bonus)
+	})
+
+	t.Run("suffers status effects", func(t *testing.T) {
+		actual := enemy.New(enemy.Goblin)
+		actual.ApplyEffect(effect.New(effect.Shield, "potion"))
+		actual.ApplyEffect(effect.New(effect.Stun, "trap"))
+		actual.ApplyEffect(effect.New(effect.Poison, "trap"))
+
+		require.True(t, actual.Stunned())
+		require.Zero(t, actual.TakeHit(internal.Attack{Min: 10, Max: 10}).Damage, "shielded")
+		require.Len(t, actual.TickEffects(effect.TurnStart), 1)
+		require.Equal(t, 98, actual.Health(), "goblins resist half the poison")
+	})
 }
@@ -0,0 +1,14 @@
+package enemy
+
+var experience = map[Kind]int{
+	Goblin: 20,
+	Orc:    35,
+	Troll:  60,
+	Dragon: 250,
+}
+
+// Experience is how many experience points defeating an enemy of this kind
+// is worth.
+func (kind Kind) Experience() int {
+	return experience[kind]
+}
@@ -0,0 +1,20 @@
+package enemy_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKindExperience(t *testing.T) {
+	t.Run("scales with the enemy kind", func(t *testing.T) {
+		require.Less(t, enemy.Goblin.Experience(), enemy.Orc.Experience())
+		require.Less(t, enemy.Orc.Experience(), enemy.Troll.Experience())
+		require.Less(t, enemy.Troll.Experience(), enemy.Dragon.Experience())
+	})
+
+	t.Run("is worthless for unknown kinds", func(t *testing.T) {
+		require.Zero(t, enemy.Kind("Hydra").Experience())
+	})
+}
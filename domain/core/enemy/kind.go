@@ -6,4 +6,11 @@ const (
 	Goblin Kind = "Goblin"
 	Orc    Kind = "Orc"
 	Troll  Kind = "Troll"
+	Dragon Kind = "Dragon"
 )
+
+// Kinds lists every enemy kind known to the game, the registered ones after
+// the built-in ones.
+func Kinds() []Kind {
+	return append([]Kind{Goblin, Orc, Troll, Dragon}, registered...)
+}
@@ -0,0 +1,61 @@
+package enemy
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/loot"
+)
+
+var tables = map[Kind]loot.Table{
+	Goblin: {
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Rusty dagger", Type: item.Weapon, Rarity: item.Common}, Weight: 40},
+			{Item: item.Item{Name: "Potion", Type: item.Potion, Rarity: item.Common}, Weight: 40},
+			{Item: item.Item{Name: "Venom fang", Type: item.Weapon, Element: element.Poison, Rarity: item.Uncommon}, Weight: 20},
+		},
+		Nothing: 50,
+		Rolls:   1,
+		Gold:    5,
+	},
+	Orc: {
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Axe", Type: item.Weapon, Rarity: item.Common}, Weight: 50},
+			{Item: item.Item{Name: "Chainmail", Type: item.Armour, Rarity: item.Uncommon}, Weight: 30},
+			{Item: item.Item{Name: "Warlord's cleaver", Type: item.Weapon, Rarity: item.Rare}, Weight: 5},
+		},
+		Nothing: 40,
+		Rolls:   1,
+		Gold:    12,
+	},
+	Troll: {
+		Guaranteed: []item.Item{{Name: "Troll hide", Type: item.Armour, Rarity: item.Uncommon}},
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Potion", Type: item.Potion, Rarity: item.Common}, Weight: 60},
+			{Item: item.Item{Name: "Frost club", Type: item.Weapon, Element: element.Ice, Rarity: item.Rare}, Weight: 10},
+		},
+		Nothing: 30,
+		Rolls:   1,
+		Gold:    25,
+	},
+	Dragon: {
+		Guaranteed: []item.Item{{Name: "Dragon scale", Type: item.Armour, Rarity: item.Epic}},
+		Entries: []loot.Entry{
+			{Item: item.Item{Name: "Potion", Type: item.Potion, Rarity: item.Common}, Weight: 50},
+			{Item: item.Item{Name: "Flame brand", Type: item.Weapon, Element: element.Fire, Rarity: item.Epic}, Weight: 20},
+			{Item: item.Item{Name: "Dragonfang", Type: item.Weapon, Element: element.Lightning, Rarity: item.Legendary}, Weight: 5},
+		},
+		Rolls: 2,
+		Gold:  200,
+	},
+}
+
+// RegisterLoot sets what enemies of the kind drop, replacing any loot table
+// the kind had.
+func RegisterLoot(kind Kind, table loot.Table) {
+	tables[kind] = table
+}
+
+// Loot is the table rolled for what defeating an enemy of this kind drops.
+func (kind Kind) Loot() loot.Table {
+	return tables[kind]
+}
@@ -0,0 +1,26 @@
+package enemy_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/loot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKindLoot(t *testing.T) {
+	t.Run("has a table for every built-in kind", func(t *testing.T) {
+		for _, kind := range []enemy.Kind{enemy.Goblin, enemy.Orc, enemy.Troll, enemy.Dragon} {
+			require.NotEmpty(t, kind.Loot().Entries, kind)
+		}
+	})
+
+	t.Run("can be replaced", func(t *testing.T) {
+		t.Cleanup(enemy.Snapshot())
+		table := loot.Table{Guaranteed: []item.Item{{Name: "Bone", Type: item.Weapon}}}
+		enemy.RegisterLoot("Skeleton", table)
+
+		require.Equal(t, table, enemy.Kind("Skeleton").Loot())
+	})
+}
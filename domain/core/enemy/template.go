@@ -0,0 +1,84 @@
+package enemy
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Template describes an enemy kind defined outside the code, such as in a
+// content pack: its life, armour and damage range along with the abilities,
+// resistances, immunities and experience of the kind.
+type Template struct {
+	Life        int
+	Armour      int
+	MinDamage   int
+	MaxDamage   int
+	Abilities   []ability.Ability
+	Resistances element.Resistances
+	Immunities  []effect.Kind
+	Experience  int
+}
+
+// body is what sets an enemy kind apart from the default enemy build.
+type body struct {
+	life   internal.Life
+	armour internal.Armour
+	attack internal.Attack
+}
+
+var bodies = map[Kind]body{}
+
+var registered []Kind
+
+// Register defines an enemy kind from a template, replacing whatever the kind
+// had. New kinds are added to Kinds.
+func Register(kind Kind, template Template) error {
+	if kind == "" {
+		return errors.New("enemy kind cannot be empty")
+	}
+
+	if template.Life <= 0 {
+		return errors.New("enemy needs some life")
+	}
+
+	if template.MinDamage < 0 || template.MaxDamage <= template.MinDamage {
+		return errors.New("enemy damage range is invalid")
+	}
+
+	bodies[kind] = body{
+		life:   internal.Life{Value: template.Life, Max: template.Life},
+		armour: internal.Armour{Value: template.Armour},
+		attack: internal.Attack{Min: template.MinDamage, Max: template.MaxDamage},
+	}
+	abilities[kind] = template.Abilities
+	resistances[kind] = template.Resistances
+	immunities[kind] = template.Immunities
+	experience[kind] = template.Experience
+
+	if !slices.Contains(Kinds(), kind) {
+		registered = append(registered, kind)
+	}
+
+	return nil
+}
+
+// Snapshot saves the registries Register, RegisterLoot and RegisterBoss
+// change, returning a function that puts them back, so that tests can
+// register kinds of their own without leaking them into one another.
+func Snapshot() func() {
+	savedBodies, savedRegistered := maps.Clone(bodies), slices.Clone(registered)
+	savedAbilities, savedResistances, savedImmunities := maps.Clone(abilities), maps.Clone(resistances), maps.Clone(immunities)
+	savedExperience, savedTables, savedBosses := maps.Clone(experience), maps.Clone(tables), maps.Clone(bosses)
+
+	return func() {
+		bodies, registered = savedBodies, savedRegistered
+		abilities, resistances, immunities = savedAbilities, savedResistances, savedImmunities
+		experience, tables, bosses = savedExperience, savedTables, savedBosses
+	}
+}
@@ -0,0 +1,58 @@
+package enemy_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(enemy.Snapshot())
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when defining a new kind", func(t *testing.T) {
+			err := enemy.Register("Wraith", enemy.Template{
+				Life:        60,
+				Armour:      3,
+				MinDamage:   5,
+				MaxDamage:   20,
+				Abilities:   []ability.Ability{ability.PowerStrike},
+				Resistances: element.Resistances{element.Physical: 50},
+				Immunities:  []effect.Kind{effect.Poison},
+				Experience:  45,
+			})
+			require.NoError(t, err)
+
+			actual := enemy.New("Wraith")
+
+			require.Equal(t, internal.Life{Value: 60, Max: 60}, actual.Life)
+			require.Equal(t, internal.Armour{Value: 3}, actual.Armour)
+			require.Equal(t, internal.Attack{Min: 5, Max: 20}, actual.Attack)
+			require.Equal(t, []ability.Ability{ability.PowerStrike}, actual.Abilities())
+			require.Equal(t, element.Resistances{element.Physical: 50}, actual.Resistances)
+			require.Equal(t, []effect.Kind{effect.Poison}, actual.Effects.Immune)
+			require.Equal(t, 45, enemy.Kind("Wraith").Experience())
+			require.Contains(t, enemy.Kinds(), enemy.Kind("Wraith"))
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the kind has no name", func(t *testing.T) {
+			require.EqualError(t, enemy.Register("", enemy.Template{Life: 10, MaxDamage: 5}), "enemy kind cannot be empty")
+		})
+
+		t.Run("when the kind has no life", func(t *testing.T) {
+			require.EqualError(t, enemy.Register("Shade", enemy.Template{MaxDamage: 5}), "enemy needs some life")
+		})
+
+		t.Run("when the damage range is empty", func(t *testing.T) {
+			require.EqualError(t, enemy.Register("Shade", enemy.Template{Life: 10, MinDamage: 5, MaxDamage: 5}), "enemy damage range is invalid")
+			require.NotContains(t, enemy.Kinds(), enemy.Kind("Shade"))
+		})
+	})
+}
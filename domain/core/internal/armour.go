@@ -1,5 +1,12 @@
 package internal
 
+// Armour soaks up incoming damage. Bonus is temporary protection, such as
+// from defending, that only lasts until the wearer's next turn.
 type Armour struct {
-    Value int
-}
\ No newline at end of file
+	Value int
+	Bonus int
+}
+
+func (armour Armour) Total() int {
+	return armour.Value + armour.Bonus
+}
@@ -1,6 +1,9 @@
 package internal
 
+import "github.com/pedrokunz/go-design-patterns/domain/core/element"
+
 type Attack struct {
-	Min int
-	Max int
+	Min     int
+	Max     int
+	Element element.Element
 }
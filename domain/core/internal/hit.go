@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"math/rand"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+)
+
+// Hit describes how a single attack was resolved against a defender.
+type Hit struct {
+	Outcome   Outcome
+	Element   element.Element
+	Roll      int
+	Mitigated int
+	Damage    int
+}
+
+// GlobalRandom draws from the shared math/rand source.
+type GlobalRandom struct{}
+
+func (GlobalRandom) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (attack Attack) Roll() int {
+	return attack.RollWith(GlobalRandom{})
+}
+
+func (attack Attack) RollWith(random dice.Random) int {
+	if attack.Max <= attack.Min {
+		return attack.Min
+	}
+
+	return random.Intn(attack.Max-attack.Min) + attack.Min
+}
+
+// Absorb applies a rolled attack to the life pool, letting the armour soak up
+// as much of it as it can. Armour never heals the defender.
+func (life *Life) Absorb(roll int, armour Armour) Hit {
+	mitigated := min(max(armour.Total(), 0), roll)
+	damage := roll - mitigated
+
+	life.Value -= damage
+
+	return Hit{Outcome: OutcomeHit, Roll: roll, Mitigated: mitigated, Damage: damage}
+}
+
+// Suffer applies a rolled attack of the given element. Resistances and
+// weaknesses scale the damage first, then armour soaks up what is left of
+// physical damage only.
+func (life *Life) Suffer(roll int, damage element.Element, armour Armour, resistances element.Resistances) Hit {
+	if damage.OrPhysical() != element.Physical {
+		armour = Armour{}
+	}
+
+	hit := life.Absorb(roll-resistances.Resist(roll, damage), armour)
+	hit.Element = damage.OrPhysical()
+	hit.Roll = roll
+	hit.Mitigated = roll - hit.Damage
+
+	return hit
+}
@@ -0,0 +1,79 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttackRoll(t *testing.T) {
+	t.Run("rolls within the attack range", func(t *testing.T) {
+		attack := internal.Attack{Min: 5, Max: 10}
+
+		for range 100 {
+			roll := attack.Roll()
+
+			require.GreaterOrEqual(t, roll, attack.Min)
+			require.Less(t, roll, attack.Max)
+		}
+	})
+
+	t.Run("rolls the minimum for a fixed attack", func(t *testing.T) {
+		require.Equal(t, 7, internal.Attack{Min: 7, Max: 7}.Roll())
+	})
+}
+
+func TestLifeAbsorb(t *testing.T) {
+	t.Run("mitigates damage with armour", func(t *testing.T) {
+		life := internal.Life{Value: 100}
+
+		hit := life.Absorb(30, internal.Armour{Value: 10})
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Roll: 30, Mitigated: 10, Damage: 20}, hit)
+		require.Equal(t, 80, life.Value)
+	})
+
+	t.Run("never heals when armour exceeds the roll", func(t *testing.T) {
+		life := internal.Life{Value: 100}
+
+		hit := life.Absorb(5, internal.Armour{Value: 10})
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Roll: 5, Mitigated: 5, Damage: 0}, hit)
+		require.Equal(t, 100, life.Value)
+	})
+}
+
+func TestArmourTotal(t *testing.T) {
+	t.Run("adds the temporary bonus", func(t *testing.T) {
+		life := internal.Life{Value: 100}
+
+		hit := life.Absorb(30, internal.Armour{Value: 10, Bonus: 5})
+
+		require.Equal(t, 15, hit.Mitigated)
+		require.Equal(t, 85, life.Value)
+	})
+}
+
+func TestLifeSuffer(t *testing.T) {
+	resistances := element.Resistances{element.Fire: -50, element.Physical: 10}
+
+	t.Run("resists before the armour soaks physical damage", func(t *testing.T) {
+		life := internal.Life{Value: 100}
+
+		hit := life.Suffer(40, "", internal.Armour{Value: 6}, resistances)
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 40, Mitigated: 10, Damage: 30}, hit)
+		require.Equal(t, 70, life.Value)
+	})
+
+	t.Run("ignores armour for elemental damage", func(t *testing.T) {
+		life := internal.Life{Value: 100}
+
+		hit := life.Suffer(40, element.Fire, internal.Armour{Value: 6}, resistances)
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Fire, Roll: 40, Mitigated: -20, Damage: 60}, hit)
+		require.Equal(t, 40, life.Value)
+	})
+}
@@ -1,5 +1,14 @@
 package internal
 
 type Life struct {
-    Value int
-}
\ No newline at end of file
+	Value int
+	Max   int
+}
+
+// Heal restores life up to its maximum, returning how much was restored.
+func (life *Life) Heal(amount int) int {
+	healed := max(min(amount, life.Max-life.Value), 0)
+	life.Value += healed
+
+	return healed
+}
@@ -0,0 +1,31 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifeHeal(t *testing.T) {
+	t.Run("restores life", func(t *testing.T) {
+		life := internal.Life{Value: 50, Max: 100}
+
+		require.Equal(t, 30, life.Heal(30))
+		require.Equal(t, 80, life.Value)
+	})
+
+	t.Run("stops at the maximum", func(t *testing.T) {
+		life := internal.Life{Value: 90, Max: 100}
+
+		require.Equal(t, 10, life.Heal(30))
+		require.Equal(t, 100, life.Value)
+	})
+
+	t.Run("never heals past an overflowing value", func(t *testing.T) {
+		life := internal.Life{Value: 120, Max: 100}
+
+		require.Zero(t, life.Heal(30))
+		require.Equal(t, 120, life.Value)
+	})
+}
@@ -0,0 +1,24 @@
+package internal
+
+import "github.com/pedrokunz/go-design-patterns/event"
+
+// Outcome is how an attack ended up once accuracy, evasion and critical
+// chance were rolled.
+type Outcome string
+
+const (
+	OutcomeHit      Outcome = event.OutcomeHit
+	OutcomeMiss     Outcome = event.OutcomeMiss
+	OutcomeDodge    Outcome = event.OutcomeDodge
+	OutcomeCritical Outcome = event.OutcomeCritical
+)
+
+// Precision holds the percentage chances that decide the outcome of an
+// attack: Accuracy to land it, Evasion to dodge it and CritChance to multiply
+// its damage by CritMultiplier.
+type Precision struct {
+	Accuracy       int
+	Evasion        int
+	CritChance     int
+	CritMultiplier float64
+}
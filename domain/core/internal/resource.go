@@ -0,0 +1,23 @@
+package internal
+
+// Resource is the mana or stamina pool abilities are paid from. It recovers
+// Regen points at the start of every turn of its owner.
+type Resource struct {
+	Value int
+	Max   int
+	Regen int
+}
+
+func (resource *Resource) Spend(cost int) bool {
+	if cost > resource.Value {
+		return false
+	}
+
+	resource.Value -= cost
+
+	return true
+}
+
+func (resource *Resource) Recover() {
+	resource.Value = min(resource.Value+resource.Regen, resource.Max)
+}
@@ -0,0 +1,28 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource(t *testing.T) {
+	t.Run("spends what it can afford", func(t *testing.T) {
+		resource := internal.Resource{Value: 20, Max: 50}
+
+		require.True(t, resource.Spend(15))
+		require.False(t, resource.Spend(15))
+		require.Equal(t, 5, resource.Value)
+	})
+
+	t.Run("recovers up to the maximum", func(t *testing.T) {
+		resource := internal.Resource{Value: 40, Max: 50, Regen: 8}
+
+		resource.Recover()
+		require.Equal(t, 48, resource.Value)
+
+		resource.Recover()
+		require.Equal(t, 50, resource.Value)
+	})
+}
@@ -0,0 +1,192 @@
+package item
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+)
+
+// Position is where an affix goes in the name of the item it is rolled on.
+type Position string
+
+const (
+	Prefix Position = "prefix"
+	Suffix Position = "suffix"
+)
+
+// Affix is a prefix or suffix generated items can roll, such as Sharp or of
+// the Bear, adding to the stats of the item it is rolled on. Types lists the
+// item types it suits, with none meaning every type. Durability only adds to
+// items that wear out.
+type Affix struct {
+	Name       string   `json:"name"`
+	Position   Position `json:"position"`
+	Types      []Type   `json:"types,omitempty"`
+	Power      int      `json:"power,omitempty"`
+	Durability int      `json:"durability,omitempty"`
+	Value      int      `json:"value,omitempty"`
+}
+
+var affixes []Affix
+
+// affixCounts is how many affixes an item of each rarity rolls.
+var affixCounts = map[Rarity]int{
+	Common:    0,
+	Uncommon:  1,
+	Rare:      2,
+	Epic:      3,
+	Legendary: 4,
+}
+
+// perPosition is how many prefixes, and how many suffixes, an item can roll.
+const perPosition = 2
+
+// RegisterAffix makes the affix one generated items can roll, replacing any
+// affix with the same name, ignoring case.
+func RegisterAffix(affix Affix) error {
+	if affix.Name == "" {
+		return errors.New("affix name cannot be empty")
+	}
+
+	if affix.Position != Prefix && affix.Position != Suffix {
+		return fmt.Errorf("unknown affix position %q", affix.Position)
+	}
+
+	for _, kind := range affix.Types {
+		if !slices.Contains(Types(), kind) {
+			return fmt.Errorf("unknown item type %q", kind)
+		}
+	}
+
+	index := slices.IndexFunc(affixes, func(registered Affix) bool { return strings.EqualFold(registered.Name, affix.Name) })
+	if index >= 0 {
+		affixes[index] = affix
+	} else {
+		affixes = append(affixes, affix)
+	}
+
+	return nil
+}
+
+// AffixesFor lists the registered affixes that suit the item type, in the
+// order they were registered.
+func AffixesFor(kind Type) []Affix {
+	var suited []Affix
+	for _, affix := range affixes {
+		if len(affix.Types) == 0 || slices.Contains(affix.Types, kind) {
+			suited = append(suited, affix)
+		}
+	}
+
+	return suited
+}
+
+// Enchant rolls affixes onto a generated item, as many as its rarity allows,
+// from the registered affixes that suit its type; no affix is rolled twice.
+// The item gets an instance of its own to carry them, even without an ID.
+func (item Item) Enchant(random dice.Random) Item {
+	var rolled []Affix
+	for range affixCounts[item.Rarity.OrCommon()] - len(item.Affixes()) {
+		carried := slices.Concat(item.Affixes(), rolled)
+		candidates := slices.DeleteFunc(AffixesFor(item.Type), func(affix Affix) bool {
+			taken := slices.ContainsFunc(carried, func(other Affix) bool { return other.Name == affix.Name })
+			full := count(carried, affix.Position) >= perPosition
+
+			return taken || full
+		})
+		if len(candidates) == 0 {
+			break
+		}
+
+		rolled = append(rolled, candidates[random.Intn(len(candidates))])
+	}
+
+	if len(rolled) == 0 {
+		return item
+	}
+
+	state := Instance{Durability: item.Durability}
+	if item.Instance != nil {
+		state = *item.Instance
+	} else {
+		serials++
+		state.Serial = serials
+	}
+
+	state.Affixes = append(slices.Clone(state.Affixes), rolled...)
+	if item.Durability > 0 {
+		for _, affix := range rolled {
+			state.Durability += affix.Durability
+		}
+	}
+
+	item.Instance = &state
+
+	return item
+}
+
+// Affixes lists the affixes rolled on the item.
+func (item Item) Affixes() []Affix {
+	if item.Instance == nil {
+		return nil
+	}
+
+	return item.Instance.Affixes
+}
+
+// Title renders the item's name from its affixes, such as Sharp Sword of the
+// Bear. A second suffix joins the first, as in of the Bear and the Fox.
+func (item Item) Title() string {
+	words := []string{}
+	var suffixes []string
+	for _, affix := range item.Affixes() {
+		if affix.Position == Prefix {
+			words = append(words, affix.Name)
+		} else {
+			suffixes = append(suffixes, affix.Name)
+		}
+	}
+
+	words = append(words, item.Name)
+	for index, suffix := range suffixes {
+		if index > 0 {
+			words = append(words, "and", strings.TrimPrefix(suffix, "of "))
+		} else {
+			words = append(words, suffix)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Called reports whether the name, ignoring case, is the item's name or its
+// title.
+func (item Item) Called(name string) bool {
+	return strings.EqualFold(item.Name, name) || strings.EqualFold(item.Title(), name)
+}
+
+// bonus sums what the item's affixes add to its stats.
+func (item Item) bonus() Affix {
+	total := Affix{}
+	for _, affix := range item.Affixes() {
+		total.Power += affix.Power
+		total.Durability += affix.Durability
+		total.Value += affix.Value
+	}
+
+	return total
+}
+
+func count(rolled []Affix, position Position) int {
+	total := 0
+	for _, affix := range rolled {
+		if affix.Position == position {
+			total++
+		}
+	}
+
+	return total
+}
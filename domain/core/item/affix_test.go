@@ -0,0 +1,121 @@
+package item_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterAffix(t *testing.T) {
+	t.Cleanup(item.Snapshot())
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when replacing an affix with the same name", func(t *testing.T) {
+			require.NoError(t, item.RegisterAffix(item.Affix{Name: "Glowing", Position: item.Prefix, Types: []item.Type{item.Torch}, Value: 1}))
+
+			err := item.RegisterAffix(item.Affix{Name: "glowing", Position: item.Prefix, Types: []item.Type{item.Torch}, Value: 3})
+
+			require.NoError(t, err)
+			require.Equal(t, []item.Affix{{Name: "glowing", Position: item.Prefix, Types: []item.Type{item.Torch}, Value: 3}}, item.AffixesFor(item.Torch))
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the affix has no name", func(t *testing.T) {
+			require.EqualError(t, item.RegisterAffix(item.Affix{Position: item.Prefix}), "affix name cannot be empty")
+		})
+
+		t.Run("when the position is unknown", func(t *testing.T) {
+			require.EqualError(t, item.RegisterAffix(item.Affix{Name: "Odd", Position: "infix"}), `unknown affix position "infix"`)
+		})
+
+		t.Run("when an item type is unknown", func(t *testing.T) {
+			err := item.RegisterAffix(item.Affix{Name: "Shiny", Position: item.Prefix, Types: []item.Type{"Jewel"}})
+
+			require.EqualError(t, err, `unknown item type "Jewel"`)
+		})
+	})
+}
+
+func TestItemEnchant(t *testing.T) {
+	t.Cleanup(item.Snapshot())
+	require.NoError(t, item.RegisterAffix(item.Affix{Name: "Keen", Position: item.Prefix, Types: []item.Type{item.Weapon}, Power: 2}))
+	require.NoError(t, item.RegisterAffix(item.Affix{Name: "Tempered", Position: item.Prefix, Types: []item.Type{item.Weapon}, Durability: 10}))
+	require.NoError(t, item.RegisterAffix(item.Affix{Name: "of the Wolf", Position: item.Suffix, Types: []item.Type{item.Weapon}, Power: 1, Value: 5}))
+
+	dagger := item.Item{ID: "dagger", Name: "Dagger", Type: item.Weapon, Power: 3, Durability: 20}
+	rarity := func(rarity item.Rarity) item.Item {
+		rolled := dagger
+		rolled.Rarity = rarity
+
+		return rolled.Clone()
+	}
+
+	t.Run("rolls no affixes on common items", func(t *testing.T) {
+		plain := rarity(item.Common)
+
+		require.Equal(t, plain, plain.Enchant(dicetest.Rolls()))
+	})
+
+	t.Run("rolls one affix on uncommon items, adding to their stats", func(t *testing.T) {
+		keen := rarity(item.Uncommon).Enchant(dicetest.Rolls())
+
+		require.Equal(t, "Keen Dagger", keen.Title())
+		require.Equal(t, 5, keen.Effectiveness())
+		require.Equal(t, "Dagger", keen.Name)
+		require.True(t, keen.Called("keen dagger"))
+		require.True(t, keen.Called("dagger"))
+	})
+
+	t.Run("rolls more affixes the rarer the item", func(t *testing.T) {
+		rare := rarity(item.Rare).Enchant(dicetest.Rolls(1, 1))
+
+		require.Equal(t, "Tempered Dagger of the Wolf", rare.Title())
+		current, durability := rare.Condition()
+		require.Equal(t, 30, current)
+		require.Equal(t, 30, durability)
+		require.Equal(t, 4, rare.Effectiveness())
+		require.Equal(t, 35*4, rare.Price())
+	})
+
+	t.Run("rolls no more affixes than suit the item", func(t *testing.T) {
+		legendary := rarity(item.Legendary).Enchant(dicetest.Rolls())
+
+		require.Len(t, legendary.Affixes(), 3)
+		require.Equal(t, "Keen Tempered Dagger of the Wolf", legendary.Title())
+	})
+
+	t.Run("gives items without a prototype an instance to carry affixes", func(t *testing.T) {
+		fang := item.Item{Name: "Fang", Type: item.Weapon, Rarity: item.Uncommon}.Enchant(dicetest.Rolls())
+
+		require.NotNil(t, fang.Instance)
+		require.Equal(t, "Keen Fang", fang.Title())
+		require.Equal(t, fang.Prototype(), item.Item{Name: "Fang", Type: item.Weapon, Rarity: item.Uncommon})
+	})
+
+	t.Run("rolls the same affixes for the same seed", func(t *testing.T) {
+		first := rarity(item.Rare).Enchant(rand.New(rand.NewSource(42)))
+		second := rarity(item.Rare).Enchant(rand.New(rand.NewSource(42)))
+
+		require.Equal(t, first.Affixes(), second.Affixes())
+	})
+}
+
+func TestItemTitle(t *testing.T) {
+	t.Run("joins a second suffix to the first", func(t *testing.T) {
+		sword := item.Item{Name: "Sword", Type: item.Weapon, Instance: &item.Instance{Affixes: []item.Affix{
+			{Name: "of the Bear", Position: item.Suffix},
+			{Name: "Sharp", Position: item.Prefix},
+			{Name: "of the Fox", Position: item.Suffix},
+		}}}
+
+		require.Equal(t, "Sharp Sword of the Bear and the Fox", sword.Title())
+	})
+
+	t.Run("is the name of items without affixes", func(t *testing.T) {
+		require.Equal(t, "Sword", item.Item{Name: "Sword"}.Title())
+	})
+}
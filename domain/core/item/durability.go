@@ -0,0 +1,92 @@
+package item
+
+import "errors"
+
+// Breakable reports whether the item wears out as it is used. Only instances
+// of items with a durability do, whether or not they have a prototype.
+func (item Item) Breakable() bool {
+	return item.Durability > 0 && item.Instance != nil
+}
+
+// Condition is how many uses the item has left out of its durability.
+func (item Item) Condition() (int, int) {
+	if !item.Breakable() {
+		return item.Durability, item.Durability
+	}
+
+	return item.Instance.Durability, item.lasting()
+}
+
+// Broken reports whether the item has worn out entirely.
+func (item Item) Broken() bool {
+	return item.Breakable() && item.Instance.Durability <= 0
+}
+
+// Worn reports whether the item is down to a quarter of its durability or
+// less, leaving it half as effective.
+func (item Item) Worn() bool {
+	return item.Breakable() && item.Instance.Durability*4 <= item.lasting()
+}
+
+// Effectiveness is the power the item lends in its condition, its affixes
+// included: all of it, half once worn and none once broken.
+func (item Item) Effectiveness() int {
+	power := item.Power + item.bonus().Power
+	switch {
+	case item.Broken():
+		return 0
+	case item.Worn():
+		return power / 2
+	default:
+		return power
+	}
+}
+
+// Wear uses the item up by the number of uses, reporting whether that broke
+// it. Items that never wear out are left as they are.
+func (item Item) Wear(uses int) bool {
+	if !item.Breakable() || item.Broken() {
+		return false
+	}
+
+	item.Instance.Durability = max(item.Instance.Durability-uses, 0)
+
+	return item.Broken()
+}
+
+// Repair restores the item to its full durability, returning how many uses
+// that gave back.
+func (item Item) Repair() (int, error) {
+	if !item.Breakable() {
+		return 0, errors.New("item cannot be repaired")
+	}
+
+	restored := item.lasting() - item.Instance.Durability
+	if restored == 0 {
+		return 0, errors.New("item needs no repair")
+	}
+
+	item.Instance.Durability = item.lasting()
+
+	return restored, nil
+}
+
+// lasting is how many uses the item lasts when new, its affixes included.
+func (item Item) lasting() int {
+	if item.Durability == 0 {
+		return 0
+	}
+
+	return item.Durability + item.bonus().Durability
+}
+
+// RepairPrice is what a merchant charges to repair the item: half its price
+// for the share of its durability that is used up, and at least one gold.
+func (item Item) RepairPrice() int {
+	current, durability := item.Condition()
+	if current >= durability {
+		return 0
+	}
+
+	return max(item.Price()*(durability-current)/durability/2, 1)
+}
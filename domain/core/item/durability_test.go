@@ -0,0 +1,81 @@
+package item_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemDurability(t *testing.T) {
+	sword := item.Item{ID: "iron-sword", Name: "Iron sword", Type: item.Weapon, Power: 8, Durability: 8}
+
+	t.Run("starts an instance at full durability", func(t *testing.T) {
+		current, durability := sword.Clone().Condition()
+
+		require.Equal(t, 8, current)
+		require.Equal(t, 8, durability)
+	})
+
+	t.Run("halves the power once worn", func(t *testing.T) {
+		worn := sword.Clone()
+
+		require.False(t, worn.Wear(5))
+		require.False(t, worn.Worn())
+		require.Equal(t, 8, worn.Effectiveness())
+
+		require.False(t, worn.Wear(1))
+		require.True(t, worn.Worn())
+		require.Equal(t, 4, worn.Effectiveness())
+	})
+
+	t.Run("breaks at zero, losing all its power", func(t *testing.T) {
+		broken := sword.Clone()
+
+		require.True(t, broken.Wear(10))
+		require.True(t, broken.Broken())
+		require.Zero(t, broken.Effectiveness())
+		require.False(t, broken.Wear(1), "already broken")
+	})
+
+	t.Run("never wears out without a durability or an instance", func(t *testing.T) {
+		club := item.Item{ID: "club", Name: "Club", Type: item.Weapon, Power: 3}.Clone()
+
+		require.False(t, club.Wear(100))
+		require.False(t, sword.Wear(100))
+		require.Equal(t, 8, sword.Effectiveness())
+	})
+
+	t.Run("wears out items without a prototype too", func(t *testing.T) {
+		spear := item.Item{Name: "Spear", Type: item.Weapon, Power: 4, Durability: 2}.Clone()
+
+		require.NotNil(t, spear.Instance)
+		require.False(t, spear.Wear(1))
+		require.True(t, spear.Wear(1))
+		require.Zero(t, spear.Effectiveness())
+		repaired, err := spear.Repair()
+		require.NoError(t, err)
+		require.Equal(t, 2, repaired)
+	})
+
+	t.Run("repairs back to full durability", func(t *testing.T) {
+		repaired := sword.Clone()
+		repaired.Wear(8)
+
+		require.Equal(t, 15, repaired.RepairPrice())
+		restored, err := repaired.Repair()
+
+		require.NoError(t, err)
+		require.Equal(t, 8, restored)
+		require.False(t, repaired.Broken())
+		require.Zero(t, repaired.RepairPrice())
+	})
+
+	t.Run("refuses repairs it does not need", func(t *testing.T) {
+		_, err := sword.Clone().Repair()
+		require.EqualError(t, err, "item needs no repair")
+
+		_, err = item.Item{Name: "Potion", Type: item.Potion}.Repair()
+		require.EqualError(t, err, "item cannot be repaired")
+	})
+}
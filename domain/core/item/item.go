@@ -1,6 +1,23 @@
 package item
 
+import "github.com/pedrokunz/go-design-patterns/domain/core/element"
+
+// Item is anything the player can pick up. Weapons may carry an element that
+// their wielder's attacks take on, and Value overrides the base price of the
+// item's type. Power is what a weapon adds to its wielder's damage, or armour
+// to its wearer's armour, and Durability how many uses it lasts, with none
+// meaning it never wears out. Items cloned from a registered prototype carry
+// its ID and an Instance of their own, where generated items keep the affixes
+// they roll.
 type Item struct {
-	Name string
-	Type Type
-}
\ No newline at end of file
+	ID         ID              `json:"id,omitempty"`
+	Name       string          `json:"name"`
+	Type       Type            `json:"type"`
+	Element    element.Element `json:"element,omitempty"`
+	Rarity     Rarity          `json:"rarity,omitempty"`
+	Value      int             `json:"value,omitempty"`
+	Power      int             `json:"power,omitempty"`
+	Durability int             `json:"durability,omitempty"`
+	// Instance is the item's own state, which content files cannot set.
+	Instance *Instance `json:"instance,omitempty" yaml:"-"`
+}
@@ -0,0 +1,35 @@
+package item
+
+var basePrices = map[Type]int{
+	Weapon:   30,
+	Armour:   25,
+	Potion:   10,
+	Torch:    5,
+	Kit:      15,
+	Material: 10,
+}
+
+var rarityMultipliers = map[Rarity]int{
+	Common:    1,
+	Uncommon:  2,
+	Rare:      4,
+	Epic:      8,
+	Legendary: 16,
+}
+
+// Price is what the item costs in gold: its own value, or the base price of
+// its type when it has none, plus what its affixes add, multiplied by its
+// rarity.
+func (item Item) Price() int {
+	value := item.Value
+	if value == 0 {
+		value = basePrices[item.Type]
+	}
+
+	return (value + item.bonus().Value) * rarityMultipliers[item.Rarity.OrCommon()]
+}
+
+// SellValue is what the item fetches when sold, half its price.
+func (item Item) SellValue() int {
+	return item.Price() / 2
+}
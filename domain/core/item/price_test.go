@@ -0,0 +1,20 @@
+package item_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemPrice(t *testing.T) {
+	t.Run("falls back to the base price of the type", func(t *testing.T) {
+		require.Equal(t, 30, item.Item{Name: "Sword", Type: item.Weapon}.Price())
+		require.Equal(t, 15, item.Item{Name: "Sword", Type: item.Weapon}.SellValue())
+	})
+
+	t.Run("scales with rarity", func(t *testing.T) {
+		require.Equal(t, 40, item.Item{Name: "Elixir", Type: item.Potion, Rarity: item.Rare}.Price())
+		require.Equal(t, 160, item.Item{Name: "Crown", Type: item.Armour, Value: 10, Rarity: item.Legendary}.Price())
+	})
+}
@@ -0,0 +1,88 @@
+package item
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// ID identifies an item definition. Unlike the name it is meant to stay the
+// same as the definition changes, so saved items keep pointing at it.
+type ID string
+
+// IDFor derives an ID from an item's name, such as iron-key for Iron key.
+func IDFor(name string) ID {
+	var id strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && id.Len() > 0 {
+				id.WriteRune('-')
+			}
+			id.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+
+	return ID(id.String())
+}
+
+// Instance is the state an item carries of its own, apart from the prototype
+// it was cloned from. Items cloned from the same prototype share everything
+// but their instance.
+type Instance struct {
+	Serial int `json:"serial"`
+	// Durability is how many uses the item has left.
+	Durability int `json:"durability"`
+	// Affixes are the affixes rolled on a generated item.
+	Affixes []Affix `json:"affixes,omitempty"`
+}
+
+// serials is the last serial handed out to an instance.
+var serials int
+
+func (instance *Instance) UnmarshalJSON(data []byte) error {
+	type plain Instance
+	if err := json.Unmarshal(data, (*plain)(instance)); err != nil {
+		return err
+	}
+
+	// Instances read back, such as from a checkpoint, keep their serials, so
+	// new ones must not be handed out again.
+	serials = max(serials, instance.Serial)
+
+	return nil
+}
+
+// Clone makes a new instance of the item with a state of its own, copied from
+// the item's. Items without an ID are not cloned from a prototype, so unless
+// they have a durability to wear down they carry no state and are returned as
+// they are.
+func (item Item) Clone() Item {
+	if item.ID == "" && item.Durability == 0 && item.Instance == nil {
+		return item
+	}
+
+	state := Instance{Durability: item.Durability}
+	if item.Instance != nil {
+		state = *item.Instance
+		state.Affixes = slices.Clone(state.Affixes)
+	}
+
+	serials++
+	state.Serial = serials
+	item.Instance = &state
+
+	return item
+}
+
+// Prototype is the item stripped of its instance, as defined.
+func (item Item) Prototype() Item {
+	item.Instance = nil
+
+	return item
+}
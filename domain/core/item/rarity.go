@@ -0,0 +1,26 @@
+package item
+
+// Rarity is how hard an item is to come by.
+type Rarity string
+
+const (
+	Common    Rarity = "common"
+	Uncommon  Rarity = "uncommon"
+	Rare      Rarity = "rare"
+	Epic      Rarity = "epic"
+	Legendary Rarity = "legendary"
+)
+
+// Rarities lists every rarity tier from the most to the least common.
+func Rarities() []Rarity {
+	return []Rarity{Common, Uncommon, Rare, Epic, Legendary}
+}
+
+// OrCommon treats an unset rarity as common.
+func (rarity Rarity) OrCommon() Rarity {
+	if rarity == "" {
+		return Common
+	}
+
+	return rarity
+}
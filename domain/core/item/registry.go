@@ -0,0 +1,101 @@
+package item
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var prototypes = map[ID]Item{}
+
+// named and typed index the prototypes by lowercase name and by type.
+var (
+	named = map[string]ID{}
+	typed = map[Type][]ID{}
+)
+
+var unknownItem = errors.New("unknown item")
+
+// Register makes the item the prototype for its ID, replacing any prototype
+// with the same ID. Names are unique, ignoring case.
+func Register(prototype Item) error {
+	if prototype.ID == "" {
+		return errors.New("item id cannot be empty")
+	}
+
+	if prototype.Name == "" {
+		return errors.New("item name cannot be empty")
+	}
+
+	if !slices.Contains(Types(), prototype.Type) {
+		return fmt.Errorf("unknown item type %q", prototype.Type)
+	}
+
+	key := strings.ToLower(prototype.Name)
+	if id, ok := named[key]; ok && id != prototype.ID {
+		return fmt.Errorf("item name %q is taken by %s", prototype.Name, id)
+	}
+
+	if previous, ok := prototypes[prototype.ID]; ok {
+		delete(named, strings.ToLower(previous.Name))
+		typed[previous.Type] = slices.DeleteFunc(typed[previous.Type], func(id ID) bool { return id == prototype.ID })
+	}
+
+	prototypes[prototype.ID] = prototype.Prototype()
+	named[key] = prototype.ID
+	typed[prototype.Type] = append(typed[prototype.Type], prototype.ID)
+
+	return nil
+}
+
+// Snapshot saves the prototypes and affixes Register and RegisterAffix
+// change, returning a function that puts them back, so that tests can
+// register items of their own without leaking them into one another.
+func Snapshot() func() {
+	savedPrototypes, savedNamed, savedAffixes := maps.Clone(prototypes), maps.Clone(named), slices.Clone(affixes)
+	savedTyped := make(map[Type][]ID, len(typed))
+	for kind, ids := range typed {
+		savedTyped[kind] = slices.Clone(ids)
+	}
+
+	return func() {
+		prototypes, named, typed, affixes = savedPrototypes, savedNamed, savedTyped, savedAffixes
+	}
+}
+
+// Lookup finds the prototype with the ID.
+func Lookup(id ID) (Item, error) {
+	prototype, ok := prototypes[id]
+	if !ok {
+		return Item{}, unknownItem
+	}
+
+	return prototype, nil
+}
+
+// Named finds the prototype with the name, ignoring case.
+func Named(name string) (Item, error) {
+	return Lookup(named[strings.ToLower(name)])
+}
+
+// OfType lists the prototypes of the type in the order they were registered.
+func OfType(kind Type) []Item {
+	found := make([]Item, 0, len(typed[kind]))
+	for _, id := range typed[kind] {
+		found = append(found, prototypes[id])
+	}
+
+	return found
+}
+
+// New clones a fresh instance of the prototype with the ID.
+func New(id ID) (Item, error) {
+	prototype, err := Lookup(id)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return prototype.Clone(), nil
+}
@@ -0,0 +1,101 @@
+package item_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(item.Snapshot())
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when registering a prototype", func(t *testing.T) {
+			err := item.Register(item.Item{ID: "rusty-mace", Name: "Rusty mace", Type: item.Weapon})
+
+			require.NoError(t, err)
+			byID, err := item.Lookup("rusty-mace")
+			require.NoError(t, err)
+			require.Equal(t, "Rusty mace", byID.Name)
+			byName, err := item.Named("RUSTY MACE")
+			require.NoError(t, err)
+			require.Equal(t, byID, byName)
+			require.Contains(t, item.OfType(item.Weapon), byID)
+		})
+
+		t.Run("when replacing a prototype with the same id", func(t *testing.T) {
+			require.NoError(t, item.Register(item.Item{ID: "old-lamp", Name: "Old lamp", Type: item.Torch}))
+
+			err := item.Register(item.Item{ID: "old-lamp", Name: "Brass lamp", Type: item.Armour})
+
+			require.NoError(t, err)
+			_, err = item.Named("Old lamp")
+			require.EqualError(t, err, "unknown item")
+			require.NotContains(t, item.OfType(item.Torch), item.Item{ID: "old-lamp", Name: "Old lamp", Type: item.Torch})
+			require.Contains(t, item.OfType(item.Armour), item.Item{ID: "old-lamp", Name: "Brass lamp", Type: item.Armour})
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the prototype has no id", func(t *testing.T) {
+			require.EqualError(t, item.Register(item.Item{Name: "Sword", Type: item.Weapon}), "item id cannot be empty")
+		})
+
+		t.Run("when the type is unknown", func(t *testing.T) {
+			require.EqualError(t, item.Register(item.Item{ID: "ring", Name: "Ring", Type: "Jewel"}), `unknown item type "Jewel"`)
+		})
+
+		t.Run("when another prototype has the name", func(t *testing.T) {
+			require.NoError(t, item.Register(item.Item{ID: "tin-cup", Name: "Tin cup", Type: item.Potion}))
+
+			err := item.Register(item.Item{ID: "cup", Name: "tin cup", Type: item.Potion})
+
+			require.EqualError(t, err, `item name "tin cup" is taken by tin-cup`)
+		})
+
+		t.Run("when looking up an unknown id", func(t *testing.T) {
+			_, err := item.New("vorpal-blade")
+
+			require.EqualError(t, err, "unknown item")
+		})
+	})
+}
+
+func TestClone(t *testing.T) {
+	t.Cleanup(item.Snapshot())
+
+	t.Run("gives every instance a state of its own", func(t *testing.T) {
+		require.NoError(t, item.Register(item.Item{ID: "short-bow", Name: "Short bow", Type: item.Weapon}))
+
+		first, err := item.New("short-bow")
+		require.NoError(t, err)
+		second := first.Clone()
+
+		require.NotEqual(t, first, second)
+		require.NotSame(t, first.Instance, second.Instance)
+		require.Greater(t, second.Instance.Serial, first.Instance.Serial)
+		require.Equal(t, first.Prototype(), second.Prototype())
+	})
+
+	t.Run("leaves items without a prototype or a durability as they are", func(t *testing.T) {
+		sword := item.Item{Name: "Sword", Type: item.Weapon}
+
+		require.Equal(t, sword, sword.Clone())
+	})
+
+	t.Run("never hands out a serial read back from a save", func(t *testing.T) {
+		saved := item.Item{}
+		require.NoError(t, json.Unmarshal([]byte(`{"id":"short-bow","name":"Short bow","type":"Weapon","instance":{"serial":9000}}`), &saved))
+
+		fresh := saved.Clone()
+
+		require.Greater(t, fresh.Instance.Serial, 9000)
+	})
+}
+
+func TestIDFor(t *testing.T) {
+	require.Equal(t, item.ID("iron-key"), item.IDFor("Iron key"))
+	require.Equal(t, item.ID("sword-of-the-bear"), item.IDFor("  Sword of the Bear!"))
+}
@@ -6,4 +6,17 @@ const (
 	Weapon Type = "Weapon"
 	Armour Type = "Armour"
 	Potion Type = "Potion"
-)
\ No newline at end of file
+	// Key opens the door of the same name.
+	Key Type = "Key"
+	// Torch lights up a dark room, burning out once used.
+	Torch Type = "Torch"
+	// Kit repairs a worn weapon or armour, used up once it does.
+	Kit Type = "Kit"
+	// Material is of no use on its own, only as an ingredient in crafting.
+	Material Type = "Material"
+)
+
+// Types lists every item type known to the game.
+func Types() []Type {
+	return []Type{Weapon, Armour, Potion, Key, Torch, Kit, Material}
+}
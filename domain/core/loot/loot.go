@@ -0,0 +1,58 @@
+package loot
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Entry is an item that can drop from a table, with a weight relative to the
+// table's other entries.
+type Entry struct {
+	Item   item.Item
+	Weight int
+}
+
+// Table decides what drops. Guaranteed items always drop, and each of the
+// Rolls picks one entry by weight, or nothing with the weight of Nothing.
+// Gold is always dropped alongside the items.
+type Table struct {
+	Guaranteed []item.Item
+	Entries    []Entry
+	Nothing    int
+	Rolls      int
+	Gold       int
+}
+
+// Roll draws the table's drops from random. Every drop is a fresh instance of
+// its item.
+func (table Table) Roll(random dice.Random) []item.Item {
+	drops := make([]item.Item, 0, len(table.Guaranteed))
+	for _, guaranteed := range table.Guaranteed {
+		drops = append(drops, guaranteed.Clone())
+	}
+
+	total := max(table.Nothing, 0)
+	for _, entry := range table.Entries {
+		total += max(entry.Weight, 0)
+	}
+
+	if total == 0 {
+		return drops
+	}
+
+	for range table.Rolls {
+		roll := random.Intn(total) - max(table.Nothing, 0)
+		for _, entry := range table.Entries {
+			if roll < 0 {
+				break
+			}
+
+			roll -= max(entry.Weight, 0)
+			if roll < 0 {
+				drops = append(drops, entry.Item.Clone())
+			}
+		}
+	}
+
+	return drops
+}
@@ -0,0 +1,54 @@
+package loot_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/loot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableRoll(t *testing.T) {
+	dagger := item.Item{Name: "Dagger", Type: item.Weapon}
+	potion := item.Item{Name: "Potion", Type: item.Potion}
+	crown := item.Item{Name: "Crown", Type: item.Armour, Rarity: item.Legendary}
+	table := loot.Table{
+		Guaranteed: []item.Item{crown},
+		Entries:    []loot.Entry{{Item: dagger, Weight: 3}, {Item: potion, Weight: 1}},
+		Nothing:    2,
+		Rolls:      1,
+	}
+
+	t.Run("always drops the guaranteed items", func(t *testing.T) {
+		require.Equal(t, []item.Item{crown}, table.Roll(dicetest.Rolls(1)), "rolled nothing")
+	})
+
+	t.Run("picks entries by weight", func(t *testing.T) {
+		require.Equal(t, []item.Item{crown, dagger}, table.Roll(dicetest.Rolls(2)))
+		require.Equal(t, []item.Item{crown, dagger}, table.Roll(dicetest.Rolls(4)))
+		require.Equal(t, []item.Item{crown, potion}, table.Roll(dicetest.Rolls(5)))
+	})
+
+	t.Run("rolls as many times as the table says", func(t *testing.T) {
+		table := table
+		table.Rolls = 3
+
+		require.Equal(t, []item.Item{crown, potion, dagger}, table.Roll(dicetest.Rolls(5, 0, 3)))
+	})
+
+	t.Run("drops the same items for the same seed", func(t *testing.T) {
+		table := table
+		table.Rolls = 10
+
+		first := table.Roll(rand.New(rand.NewSource(42)))
+		second := table.Roll(rand.New(rand.NewSource(42)))
+
+		require.Equal(t, first, second)
+	})
+
+	t.Run("drops nothing from an empty table", func(t *testing.T) {
+		require.Empty(t, loot.Table{Rolls: 3}.Roll(dicetest.Rolls()))
+	})
+}
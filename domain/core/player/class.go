@@ -0,0 +1,170 @@
+package player
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Class is the archetype a player picks at creation. Classes are plain data
+// kept in a registry, so new ones only need to be registered.
+type Class struct {
+	Name        string
+	Life        internal.Life
+	Armour      internal.Armour
+	Attack      internal.Attack
+	Resource    internal.Resource
+	Precision   internal.Precision
+	Resistances element.Resistances
+	Growth      Growth
+	Equipment   []item.Type
+	Ability     ability.ID
+}
+
+var (
+	Warrior = Class{
+		Name:      "Warrior",
+		Life:      internal.Life{Value: 130, Max: 130},
+		Armour:    internal.Armour{Value: 3},
+		Attack:    internal.Attack{Min: 10, Max: 80},
+		Resource:  internal.Resource{Value: 60, Max: 60, Regen: 6},
+		Precision: internal.Precision{Accuracy: 90, Evasion: 5, CritChance: 5, CritMultiplier: 2},
+		Growth:    Growth{Life: 15, Armour: 2, Attack: 4},
+		Equipment: []item.Type{item.Weapon, item.Armour},
+		Ability:   ability.PowerStrike.ID,
+	}
+	Rogue = Class{
+		Name:        "Rogue",
+		Life:        internal.Life{Value: 100, Max: 100},
+		Armour:      internal.Armour{Value: 1},
+		Attack:      internal.Attack{Min: 20, Max: 90},
+		Resource:    internal.Resource{Value: 50, Max: 50, Regen: 8},
+		Precision:   internal.Precision{Accuracy: 95, Evasion: 20, CritChance: 20, CritMultiplier: 2.5},
+		Resistances: element.Resistances{element.Poison: 25},
+		Growth:      Growth{Life: 10, Armour: 1, Attack: 6},
+		Equipment:   []item.Type{item.Weapon},
+		Ability:     ability.PoisonStrike.ID,
+	}
+	Mage = Class{
+		Name:        "Mage",
+		Life:        internal.Life{Value: 80, Max: 80},
+		Armour:      internal.Armour{Value: 0},
+		Attack:      internal.Attack{Min: 30, Max: 100},
+		Resource:    internal.Resource{Value: 100, Max: 100, Regen: 10},
+		Precision:   internal.Precision{Accuracy: 95, Evasion: 5, CritChance: 10, CritMultiplier: 1.5},
+		Resistances: element.Resistances{element.Fire: 25, element.Ice: 25, element.Lightning: 25, element.Physical: -10},
+		Growth:      Growth{Life: 6, Armour: 0, Attack: 8},
+		Equipment:   []item.Type{},
+		Ability:     ability.AreaAttack.ID,
+	}
+)
+
+var classes = map[string]Class{
+	strings.ToLower(Warrior.Name): Warrior,
+	strings.ToLower(Rogue.Name):   Rogue,
+	strings.ToLower(Mage.Name):    Mage,
+}
+
+var unknownClass = errors.New("unknown class")
+
+// RegisterClass makes a class selectable, replacing any class with the same
+// name.
+func RegisterClass(class Class) error {
+	if class.Name == "" {
+		return errors.New("class name cannot be empty")
+	}
+
+	classes[strings.ToLower(class.Name)] = class
+
+	return nil
+}
+
+// LookupClass finds a registered class by name, ignoring case.
+func LookupClass(name string) (Class, error) {
+	class, ok := classes[strings.ToLower(name)]
+	if !ok {
+		return Class{}, unknownClass
+	}
+
+	return class, nil
+}
+
+// Classes lists every registered class ordered by name.
+func Classes() []Class {
+	registered := make([]Class, 0, len(classes))
+	for _, class := range classes {
+		registered = append(registered, class)
+	}
+
+	slices.SortFunc(registered, func(a, b Class) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return registered
+}
+
+func (class Class) CanEquip(itemType item.Type) bool {
+	return slices.Contains(class.Equipment, itemType)
+}
+
+// NewWithClass creates a player from the class base stats, knowing the class
+// starting ability when it is registered.
+func NewWithClass(name string, class Class) *Player {
+	p := &Player{
+		Name:        name,
+		Level:       1,
+		Class:       &class,
+		Armour:      class.Armour,
+		Attack:      class.Attack,
+		Life:        class.Life,
+		Precision:   class.Precision,
+		Speed:       baseSpeed,
+		Resistances: class.Resistances,
+		Kit:         ability.Kit{Resource: class.Resource},
+	}
+
+	if starting, err := ability.Lookup(class.Ability); err == nil {
+		p.Learn(starting)
+	}
+
+	return p
+}
+
+// Equip moves an item from the inventory into its equipment slot, returning
+// whatever was equipped there before back to the inventory.
+func (p *Player) Equip(equipment item.Item) error {
+	if p.Class != nil && !p.Class.CanEquip(equipment.Type) {
+		return errors.New("item type not allowed for class")
+	}
+
+	if equipment.Type != item.Weapon && equipment.Type != item.Armour {
+		return errors.New("item cannot be equipped")
+	}
+
+	if equipment.Broken() {
+		return errors.New("item is broken")
+	}
+
+	index := slices.Index(p.Inventory, equipment)
+	if index < 0 {
+		return errors.New("item not in inventory")
+	}
+
+	p.Inventory = slices.Delete(p.Inventory, index, index+1)
+
+	if previous, ok := p.Equipment[equipment.Type]; ok {
+		p.Inventory = append(p.Inventory, previous)
+	}
+
+	if p.Equipment == nil {
+		p.Equipment = make(map[item.Type]item.Item)
+	}
+	p.Equipment[equipment.Type] = equipment
+
+	return nil
+}
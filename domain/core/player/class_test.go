@@ -0,0 +1,153 @@
+package player_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassRegistry(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when looking up a built in class", func(t *testing.T) {
+			actual, err := player.LookupClass("warrior")
+
+			require.NoError(t, err)
+			require.Equal(t, player.Warrior, actual)
+		})
+
+		t.Run("when registering a new class", func(t *testing.T) {
+			paladin := player.Class{
+				Name:      "Paladin",
+				Life:      internal.Life{Value: 120, Max: 120},
+				Attack:    internal.Attack{Min: 5, Max: 60},
+				Equipment: []item.Type{item.Weapon, item.Armour},
+				Ability:   "heal",
+			}
+
+			require.NoError(t, player.RegisterClass(paladin))
+
+			actual, err := player.LookupClass("Paladin")
+			require.NoError(t, err)
+			require.Equal(t, paladin, actual)
+			require.Contains(t, player.Classes(), paladin)
+		})
+
+		t.Run("when listing classes", func(t *testing.T) {
+			names := make([]string, 0)
+			for _, class := range player.Classes() {
+				names = append(names, class.Name)
+			}
+
+			require.IsNonDecreasing(t, names)
+			require.Subset(t, names, []string{"Mage", "Rogue", "Warrior"})
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when class is unknown", func(t *testing.T) {
+			_, err := player.LookupClass("bard")
+
+			require.EqualError(t, err, "unknown class")
+		})
+
+		t.Run("when class has no name", func(t *testing.T) {
+			err := player.RegisterClass(player.Class{})
+
+			require.EqualError(t, err, "class name cannot be empty")
+		})
+	})
+}
+
+func TestNewWithClass(t *testing.T) {
+	t.Run("uses the class base stats", func(t *testing.T) {
+		actual := player.NewWithClass("Elmster", player.Mage)
+
+		require.Equal(t, player.Mage.Life, actual.Life)
+		require.Equal(t, player.Mage.Armour, actual.Armour)
+		require.Equal(t, player.Mage.Attack, actual.Attack)
+		require.Equal(t, 1, actual.Level)
+		require.Equal(t, ability.AreaAttack.ID, actual.Class.Ability)
+		require.Equal(t, []ability.Ability{ability.AreaAttack}, actual.Abilities())
+		require.Equal(t, player.Mage.Resource, actual.Resource)
+	})
+
+	t.Run("starts rogues off with a poisoned blade", func(t *testing.T) {
+		actual := player.NewWithClass("Elmster", player.Rogue)
+
+		require.Equal(t, []ability.Ability{ability.PoisonStrike}, actual.Abilities())
+	})
+
+	t.Run("grows as the class dictates", func(t *testing.T) {
+		actual := player.NewWithClass("Elmster", player.Warrior)
+
+		actual.GainExperience(100, player.DefaultProgression)
+
+		require.Equal(t, 145, actual.Life.Value)
+		require.Equal(t, 5, actual.Armour.Value)
+		require.Equal(t, internal.Attack{Min: 14, Max: 84}, actual.Attack)
+	})
+}
+
+func TestPlayerEquip(t *testing.T) {
+	sword := item.Item{Name: "Sword", Type: item.Weapon}
+	axe := item.Item{Name: "Axe", Type: item.Weapon}
+	shield := item.Item{Name: "Shield", Type: item.Armour}
+	potion := item.Item{Name: "Potion", Type: item.Potion}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the class allows the item", func(t *testing.T) {
+			actual := player.NewWithClass("Elmster", player.Warrior)
+			actual.Collect(sword, shield, axe)
+
+			require.NoError(t, actual.Equip(sword))
+			require.NoError(t, actual.Equip(shield))
+			require.NoError(t, actual.Equip(axe))
+
+			require.Equal(t, map[item.Type]item.Item{item.Weapon: axe, item.Armour: shield}, actual.Equipment)
+			require.Equal(t, []item.Item{sword}, actual.Inventory)
+		})
+
+		t.Run("when the player has no class", func(t *testing.T) {
+			actual := player.New("Elmster")
+			actual.Collect(shield)
+
+			require.NoError(t, actual.Equip(shield))
+		})
+
+		t.Run("when the weapon carries an element", func(t *testing.T) {
+			actual := player.NewWithClass("Elmster", player.Warrior)
+			brand := item.Item{Name: "Flame brand", Type: item.Weapon, Element: element.Fire}
+			actual.Collect(brand)
+
+			require.NoError(t, actual.Equip(brand))
+			require.Equal(t, element.Fire, actual.Offense().Element)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the class does not allow the item", func(t *testing.T) {
+			actual := player.NewWithClass("Elmster", player.Rogue)
+			actual.Collect(shield)
+
+			require.EqualError(t, actual.Equip(shield), "item type not allowed for class")
+		})
+
+		t.Run("when the item cannot be equipped", func(t *testing.T) {
+			actual := player.New("Elmster")
+			actual.Collect(potion)
+
+			require.EqualError(t, actual.Equip(potion), "item cannot be equipped")
+		})
+
+		t.Run("when the item is not in the inventory", func(t *testing.T) {
+			actual := player.New("Elmster")
+
+			require.EqualError(t, actual.Equip(sword), "item not in inventory")
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package player
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Wear uses up the item equipped in the slot once, such as a weapon for every
+// blow it lands. An item that breaks is unequipped back into the inventory,
+// where it can be repaired. It returns the item and whether it broke.
+func (p *Player) Wear(slot item.Type) (item.Item, bool) {
+	equipped, ok := p.Equipment[slot]
+	if !ok || !equipped.Wear(1) {
+		return equipped, false
+	}
+
+	delete(p.Equipment, slot)
+	p.Inventory = append(p.Inventory, equipped)
+
+	return equipped, true
+}
+
+// Carried finds the named item, ignoring case, among what the player has
+// equipped and then in the inventory. Items go by their name or their title.
+func (p *Player) Carried(name string) (item.Item, bool) {
+	for _, slot := range []item.Type{item.Weapon, item.Armour} {
+		if equipped, ok := p.Equipment[slot]; ok && equipped.Called(name) {
+			return equipped, true
+		}
+	}
+
+	index := slices.IndexFunc(p.Inventory, func(carried item.Item) bool { return carried.Called(name) })
+	if index < 0 {
+		return item.Item{}, false
+	}
+
+	return p.Inventory[index], true
+}
+
+// Mend repairs the named item with a repair kit from the inventory, using the
+// kit up.
+func (p *Player) Mend(name string) (item.Item, error) {
+	repaired, ok := p.Carried(name)
+	if !ok {
+		return item.Item{}, errors.New("item not carried")
+	}
+
+	index := slices.IndexFunc(p.Inventory, func(carried item.Item) bool { return carried.Type == item.Kit })
+	if index < 0 {
+		return item.Item{}, errors.New("no repair kit")
+	}
+
+	if _, err := repaired.Repair(); err != nil {
+		return item.Item{}, err
+	}
+
+	p.Inventory = slices.Delete(p.Inventory, index, index+1)
+
+	return repaired, nil
+}
+
+// Belongings lists everything the player carries: what they have equipped,
+// then the inventory.
+func (p *Player) Belongings() []item.Item {
+	var belongings []item.Item
+	for _, slot := range []item.Type{item.Weapon, item.Armour} {
+		if equipped, ok := p.Equipment[slot]; ok {
+			belongings = append(belongings, equipped)
+		}
+	}
+
+	return append(belongings, p.Inventory...)
+}
+
+// UseUp takes the named item, ignoring case, out of the inventory for good,
+// such as an ingredient for crafting. When the inventory holds none it is
+// taken off the equipment instead.
+func (p *Player) UseUp(name string) (item.Item, error) {
+	index := slices.IndexFunc(p.Inventory, func(carried item.Item) bool { return carried.Called(name) })
+	if index >= 0 {
+		used := p.Inventory[index]
+		p.Inventory = slices.Delete(p.Inventory, index, index+1)
+
+		return used, nil
+	}
+
+	for _, slot := range []item.Type{item.Weapon, item.Armour} {
+		if equipped, ok := p.Equipment[slot]; ok && equipped.Called(name) {
+			delete(p.Equipment, slot)
+
+			return equipped, nil
+		}
+	}
+
+	return item.Item{}, errors.New("item not carried")
+}
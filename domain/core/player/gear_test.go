@@ -0,0 +1,102 @@
+package player_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerGear(t *testing.T) {
+	sword := item.Item{ID: "iron-sword", Name: "Iron sword", Type: item.Weapon, Power: 6, Durability: 4}
+	shield := item.Item{ID: "buckler", Name: "Buckler", Type: item.Armour, Power: 4, Durability: 4}
+	kit := item.Item{Name: "Repair kit", Type: item.Kit}
+
+	equip := func(t *testing.T, gear ...item.Item) *player.Player {
+		p := player.New("Elmster")
+		for _, worn := range gear {
+			p.Collect(worn)
+			require.NoError(t, p.Equip(worn))
+		}
+
+		return p
+	}
+
+	t.Run("lends the power of the equipped gear", func(t *testing.T) {
+		p := equip(t, sword.Clone(), shield.Clone())
+
+		require.Equal(t, 7, p.Offense().Min)
+		require.Equal(t, 106, p.Offense().Max)
+		require.Equal(t, 4, p.Receive(8, element.Physical).Mitigated)
+	})
+
+	t.Run("lends half the power of worn gear", func(t *testing.T) {
+		p := equip(t, sword.Clone())
+
+		for range 3 {
+			_, broke := p.Wear(item.Weapon)
+			require.False(t, broke)
+		}
+
+		require.Equal(t, 4, p.Offense().Min)
+	})
+
+	t.Run("unequips gear that breaks", func(t *testing.T) {
+		p := equip(t, sword.Clone())
+		for range 3 {
+			p.Wear(item.Weapon)
+		}
+
+		broken, broke := p.Wear(item.Weapon)
+
+		require.True(t, broke)
+		require.Equal(t, "Iron sword", broken.Name)
+		require.NotContains(t, p.Equipment, item.Weapon)
+		require.Equal(t, []item.Item{broken}, p.Inventory)
+		require.EqualError(t, p.Equip(broken), "item is broken")
+	})
+
+	t.Run("mends gear with a repair kit", func(t *testing.T) {
+		p := equip(t, sword.Clone())
+		p.Wear(item.Weapon)
+		p.Collect(kit)
+
+		mended, err := p.Mend("iron SWORD")
+
+		require.NoError(t, err)
+		current, _ := mended.Condition()
+		require.Equal(t, 4, current)
+		require.Empty(t, p.Inventory, "the kit is used up")
+	})
+
+	t.Run("fails to mend without a kit or the item", func(t *testing.T) {
+		p := equip(t, sword.Clone())
+		p.Wear(item.Weapon)
+
+		_, err := p.Mend("Iron sword")
+		require.EqualError(t, err, "no repair kit")
+
+		_, err = p.Mend("Buckler")
+		require.EqualError(t, err, "item not carried")
+	})
+	t.Run("uses items up from the inventory before the equipment", func(t *testing.T) {
+		p := equip(t, sword.Clone())
+		spare := sword.Clone()
+		p.Collect(spare)
+		require.Len(t, p.Belongings(), 2)
+
+		used, err := p.UseUp("iron sword")
+		require.NoError(t, err)
+		require.Equal(t, spare, used)
+		require.Empty(t, p.Inventory)
+
+		_, err = p.UseUp("Iron sword")
+		require.NoError(t, err)
+		require.Empty(t, p.Equipment)
+
+		_, err = p.UseUp("Iron sword")
+		require.EqualError(t, err, "item not carried")
+	})
+}
@@ -0,0 +1,60 @@
+package player
+
+// Curve returns the total experience needed to reach the given level. It
+// must keep rising, as levelling stops wherever it does not.
+type Curve func(level int) int
+
+// DefaultCurve asks for 100 experience to reach level 2, 300 for level 3,
+// 600 for level 4 and so on.
+func DefaultCurve(level int) int {
+	return 50 * (level - 1) * level
+}
+
+// Growth is how much each stat increases when the player levels up.
+type Growth struct {
+	Life   int
+	Armour int
+	Attack int
+}
+
+type Progression struct {
+	Curve  Curve
+	Growth Growth
+}
+
+var DefaultProgression = Progression{
+	Curve:  DefaultCurve,
+	Growth: Growth{Life: 10, Armour: 1, Attack: 5},
+}
+
+// GainExperience awards experience points and applies the stat growth for
+// every level reached, returning how many levels were gained. Players with a
+// class grow as their class dictates rather than by the progression growth.
+// No level is gained past the point where the curve stops rising, so a flat
+// curve cannot level the player up forever.
+func (p *Player) GainExperience(points int, progression Progression) (levels int) {
+	p.Experience += points
+
+	growth := progression.Growth
+	if p.Class != nil {
+		growth = p.Class.Growth
+	}
+
+	for {
+		next := progression.Curve(p.Level + 1)
+		if p.Experience < next || next <= progression.Curve(p.Level) {
+			break
+		}
+
+		p.Level++
+		levels++
+
+		p.Life.Max += growth.Life
+		p.Life.Value += growth.Life
+		p.Armour.Value += growth.Armour
+		p.Attack.Min += growth.Attack
+		p.Attack.Max += growth.Attack
+	}
+
+	return levels
+}
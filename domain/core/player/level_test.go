@@ -0,0 +1,70 @@
+package player_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCurve(t *testing.T) {
+	t.Run("grows with each level", func(t *testing.T) {
+		require.Equal(t, 0, player.DefaultCurve(1))
+		require.Equal(t, 100, player.DefaultCurve(2))
+		require.Equal(t, 300, player.DefaultCurve(3))
+		require.Equal(t, 600, player.DefaultCurve(4))
+	})
+}
+
+func TestPlayerGainExperience(t *testing.T) {
+	t.Run("keeps the level below the threshold", func(t *testing.T) {
+		actual := player.New("Elmster")
+
+		levels := actual.GainExperience(99, player.DefaultProgression)
+
+		require.Zero(t, levels)
+		require.Equal(t, 1, actual.Level)
+		require.Equal(t, 99, actual.Experience)
+		require.Equal(t, internal.Life{Value: 100, Max: 100}, actual.Life)
+	})
+
+	t.Run("applies the growth of every level reached", func(t *testing.T) {
+		actual := player.New("Elmster")
+
+		levels := actual.GainExperience(350, player.DefaultProgression)
+
+		require.Equal(t, 2, levels)
+		require.Equal(t, 3, actual.Level)
+		require.Equal(t, 350, actual.Experience)
+		require.Equal(t, internal.Life{Value: 120, Max: 120}, actual.Life)
+		require.Equal(t, internal.Armour{Value: 2}, actual.Armour)
+		require.Equal(t, internal.Attack{Min: 11, Max: 110}, actual.Attack)
+	})
+
+	t.Run("follows a custom curve", func(t *testing.T) {
+		actual := player.New("Elmster")
+		progression := player.Progression{
+			Curve:  func(level int) int { return 10 * (level - 1) },
+			Growth: player.Growth{Life: 1},
+		}
+
+		levels := actual.GainExperience(25, progression)
+
+		require.Equal(t, 2, levels)
+		require.Equal(t, internal.Life{Value: 102, Max: 102}, actual.Life)
+	})
+
+	t.Run("stops where the curve stops rising", func(t *testing.T) {
+		actual := player.New("Elmster")
+		progression := player.Progression{
+			Curve:  func(level int) int { return min(10*(level-1), 20) },
+			Growth: player.Growth{Life: 1},
+		}
+
+		levels := actual.GainExperience(100, progression)
+
+		require.Equal(t, 2, levels)
+		require.Equal(t, 3, actual.Level)
+	})
+}
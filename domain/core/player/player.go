@@ -1,30 +1,144 @@
 package player
 
 import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
 	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
-	"math/rand"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
 )
 
+// baseSpeed is how fast a player moves when nothing slows them down.
+const baseSpeed = 10
+
 type Player struct {
-	Name   string
-	Armour internal.Armour
-	Life   internal.Life
-	Attack internal.Attack
+	Name        string
+	Level       int
+	Experience  int
+	Class       *Class
+	Armour      internal.Armour
+	Life        internal.Life
+	Attack      internal.Attack
+	Precision   internal.Precision
+	Speed       int
+	Resistances element.Resistances
+	Gold        int
+	Inventory   []item.Item
+	Equipment   map[item.Type]item.Item
+	Effects     effect.Set
+	// Surroundings is wherever the player is, which may change their
+	// figures. It is not part of what gets saved.
+	Surroundings Surroundings `json:"-"`
+	ability.Kit
 }
 
 func New(name string) *Player {
 	return &Player{
 		Name:   name,
+		Level:  1,
 		Armour: internal.Armour{Value: 0},
 		Attack: internal.Attack{Min: 1, Max: 100},
-		Life:   internal.Life{Value: 100},
+		Life:   internal.Life{Value: 100, Max: 100},
+		Precision: internal.Precision{
+			Accuracy:       90,
+			Evasion:        5,
+			CritChance:     5,
+			CritMultiplier: 2,
+		},
+		Speed: baseSpeed,
+		Kit: ability.Kit{
+			Resource: internal.Resource{Value: 50, Max: 50, Regen: 5},
+		},
 	}
 }
 
+func (p *Player) String() string {
+	return p.Name
+}
+
+// Offense is the player's attack, sharpened by the equipped weapon as far as
+// its condition allows and taking on its element.
+func (p *Player) Offense() internal.Attack {
+	attack := p.Attack
+	if weapon, ok := p.Equipment[item.Weapon]; ok {
+		attack.Min += weapon.Effectiveness()
+		attack.Max += weapon.Effectiveness()
+		if weapon.Element != "" {
+			attack.Element = weapon.Element
+		}
+	}
+
+	return attack
+}
+
+func (p *Player) Odds() internal.Precision {
+	odds := p.Precision
+	odds.Accuracy = p.adjust(StatAccuracy, odds.Accuracy)
+	odds.Evasion = p.adjust(StatEvasion, odds.Evasion)
+	odds.CritChance = p.adjust(StatCritChance, odds.CritChance)
+
+	return odds
+}
+
+func (p *Player) Health() int {
+	return p.Life.Value
+}
+
+func (p *Player) MaxHealth() int {
+	return p.Life.Max
+}
+
+// Initiative is the player's speed, as much as their surroundings allow. The
+// player strikes first unless their foe is faster.
+func (p *Player) Initiative() int {
+	return p.adjust(StatSpeed, p.Speed)
+}
+
+// Heal restores life, as much as the player's surroundings allow.
+func (p *Player) Heal(amount int) int {
+	return p.Life.Heal(p.adjust(StatHealing, amount))
+}
+
+func (p *Player) Guard(amount int) {
+	p.Armour.Bonus += amount
+}
+
+// StartTurn drops any guard from the previous turn, recovers the resource pool
+// and moves every cooldown one turn closer to ready.
+func (p *Player) StartTurn() {
+	p.Armour.Bonus = 0
+	p.Kit.Tick()
+}
+
 func (p *Player) TakeDamage(attack internal.Attack) int {
-	damage := rand.Intn(attack.Max-attack.Min) + attack.Min
+	return p.TakeHit(attack).Roll
+}
+
+func (p *Player) TakeHit(attack internal.Attack) internal.Hit {
+	return p.Receive(attack.Roll(), attack.Element)
+}
 
-	p.Life.Value -= damage - p.Armour.Value
+// Receive suffers a rolled attack through the resistances, the armour, both
+// the player's own and what the equipped armour lends, and any active shields.
+func (p *Player) Receive(roll int, damage element.Element) internal.Hit {
+	armour := p.Armour
+	armour.Bonus += p.Effects.Protection() + p.Equipment[item.Armour].Effectiveness()
+
+	return p.Life.Suffer(roll, damage, armour, p.Resistances)
+}
+
+func (p *Player) ApplyEffect(applied effect.Effect) bool {
+	return p.Effects.Apply(applied)
+}
+
+func (p *Player) TickEffects(phase effect.Phase) []effect.Tick {
+	return p.Effects.Tick(phase, &p.Life, p.Resistances, p)
+}
+
+func (p *Player) Stunned() bool {
+	return p.Effects.Has(effect.Stun)
+}
 
-	return damage
+func (p *Player) Collect(items ...item.Item) {
+	p.Inventory = append(p.Inventory, items...)
 }
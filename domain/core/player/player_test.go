@@ -4,7 +4,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"testing"
 
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
 	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
 	"github.com/pedrokunz/go-design-patterns/domain/core/player"
 )
 
@@ -13,11 +17,97 @@ func TestPlayer(t *testing.T) {
 		actual := player.New("Elmster")
 		expected := &player.Player{
 			Name:   "Elmster",
+			Level:  1,
 			Armour: internal.Armour{Value: 0},
 			Attack: internal.Attack{Min: 1, Max: 100},
-			Life:   internal.Life{Value: 100},
+			Life:   internal.Life{Value: 100, Max: 100},
+			Precision: internal.Precision{
+				Accuracy:       90,
+				Evasion:        5,
+				CritChance:     5,
+				CritMultiplier: 2,
+			},
+			Speed: 10,
+			Kit: ability.Kit{
+				Resource: internal.Resource{Value: 50, Max: 50, Regen: 5},
+			},
 		}
 
 		require.Equal(t, actual, expected, "actual %v, expected %v", actual, expected)
 	})
 }
+
+func TestPlayerTakeHit(t *testing.T) {
+	t.Run("takes damage reduced by armour", func(t *testing.T) {
+		actual := player.New("Elmster")
+		actual.Armour.Value = 2
+
+		hit := actual.TakeHit(internal.Attack{Min: 10, Max: 11})
+
+		require.Equal(t, internal.Hit{Outcome: internal.OutcomeHit, Element: element.Physical, Roll: 10, Mitigated: 2, Damage: 8}, hit)
+		require.Equal(t, 92, actual.Health())
+		require.Equal(t, "Elmster", actual.String())
+		require.Equal(t, actual.Attack, actual.Offense())
+	})
+
+	t.Run("reports the rolled damage", func(t *testing.T) {
+		actual := player.New("Elmster")
+
+		damage := actual.TakeDamage(internal.Attack{Min: 10, Max: 11})
+
+		require.Equal(t, 10, damage)
+		require.Equal(t, 90, actual.Health())
+	})
+}
+
+func TestPlayerCollect(t *testing.T) {
+	t.Run("adds items to the inventory", func(t *testing.T) {
+		actual := player.New("Elmster")
+		sword := item.Item{Name: "Sword", Type: item.Weapon}
+		shield := item.Item{Name: "Shield", Type: item.Armour}
+
+		actual.Collect(sword)
+		actual.Collect(shield)
+
+		require.Equal(t, []item.Item{sword, shield}, actual.Inventory)
+	})
+}
+
+func TestPlayerTurn(t *testing.T) {
+	t.Run("heals up to the maximum life", func(t *testing.T) {
+		actual := player.New("Elmster")
+		actual.Life.Value = 90
+
+		require.Equal(t, 10, actual.Heal(30))
+		require.Equal(t, 100, actual.Health())
+	})
+
+	t.Run("guards until the next turn", func(t *testing.T) {
+		actual := player.New("Elmster")
+		actual.Resource.Value = 10
+		actual.Learn(ability.Defend)
+		_, err := actual.Use(ability.Defend.ID)
+		require.NoError(t, err)
+
+		actual.Guard(20)
+		hit := actual.TakeHit(internal.Attack{Min: 10, Max: 11})
+		require.Zero(t, hit.Damage)
+
+		actual.StartTurn()
+		require.Zero(t, actual.Armour.Bonus)
+		require.Equal(t, 10, actual.Resource.Value)
+		require.Equal(t, map[ability.ID]int{ability.Defend.ID: 1}, actual.Cooldowns)
+	})
+
+	t.Run("suffers status effects", func(t *testing.T) {
+		actual := player.New("Elmster")
+		actual.ApplyEffect(effect.New(effect.Shield, "potion"))
+		actual.ApplyEffect(effect.New(effect.Stun, "trap"))
+		actual.ApplyEffect(effect.New(effect.Poison, "trap"))
+
+		require.True(t, actual.Stunned())
+		require.Zero(t, actual.TakeHit(internal.Attack{Min: 10, Max: 10}).Damage, "shielded")
+		require.Len(t, actual.TickEffects(effect.TurnStart), 1)
+		require.Equal(t, 96, actual.Health())
+	})
+}
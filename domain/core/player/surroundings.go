@@ -0,0 +1,28 @@
+package player
+
+// Stat is a figure of the player's that their surroundings can change.
+type Stat string
+
+const (
+	StatHealing    Stat = "healing"
+	StatAccuracy   Stat = "accuracy"
+	StatEvasion    Stat = "evasion"
+	StatCritChance Stat = "crit_chance"
+	StatSpeed      Stat = "speed"
+)
+
+// Surroundings change the player's figures while they are somewhere, such as
+// a cursed room weakening their healing. Adjust returns the value the stat
+// takes there.
+type Surroundings interface {
+	Adjust(stat Stat, value int) int
+}
+
+// adjust runs the value through the player's surroundings, if any.
+func (p *Player) adjust(stat Stat, value int) int {
+	if p.Surroundings == nil {
+		return value
+	}
+
+	return p.Surroundings.Adjust(stat, value)
+}
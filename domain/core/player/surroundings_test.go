@@ -0,0 +1,37 @@
+package player_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+// Marsh halves healing and evasion.
+type Marsh struct{}
+
+func (Marsh) Adjust(stat player.Stat, value int) int {
+	if stat == player.StatHealing || stat == player.StatEvasion {
+		return value / 2
+	}
+
+	return value
+}
+
+func TestPlayerSurroundings(t *testing.T) {
+	t.Run("change healing and odds while the player is there", func(t *testing.T) {
+		actual := player.New("Elmster")
+		actual.Life.Value = 50
+		actual.Precision.Evasion = 20
+		actual.Surroundings = Marsh{}
+
+		require.Equal(t, 10, actual.Heal(20))
+		require.Equal(t, 10, actual.Odds().Evasion)
+		require.Equal(t, 90, actual.Odds().Accuracy)
+
+		actual.Surroundings = nil
+
+		require.Equal(t, 20, actual.Heal(20))
+		require.Equal(t, 20, actual.Odds().Evasion)
+	})
+}
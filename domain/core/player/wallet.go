@@ -0,0 +1,40 @@
+package player
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+var notEnoughGold = errors.New("not enough gold")
+
+// Earn adds gold to the player's purse.
+func (p *Player) Earn(gold int) {
+	p.Gold += max(gold, 0)
+}
+
+// Pay takes gold from the player's purse, failing without taking any when
+// the player cannot afford it.
+func (p *Player) Pay(gold int) error {
+	if gold > p.Gold {
+		return notEnoughGold
+	}
+
+	p.Gold -= max(gold, 0)
+
+	return nil
+}
+
+// Discard removes an item from the inventory, such as when selling it.
+// Equipped items have to be swapped out first.
+func (p *Player) Discard(discarded item.Item) error {
+	index := slices.Index(p.Inventory, discarded)
+	if index < 0 {
+		return errors.New("item not in inventory")
+	}
+
+	p.Inventory = slices.Delete(p.Inventory, index, index+1)
+
+	return nil
+}
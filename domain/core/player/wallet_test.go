@@ -0,0 +1,46 @@
+package player_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerWallet(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when paying what the player can afford", func(t *testing.T) {
+			actual := player.New("Elmster")
+			actual.Earn(30)
+
+			require.NoError(t, actual.Pay(25))
+			require.Equal(t, 5, actual.Gold)
+		})
+
+		t.Run("when discarding an item in the inventory", func(t *testing.T) {
+			actual := player.New("Elmster")
+			potion := item.Item{Name: "Potion", Type: item.Potion}
+			actual.Collect(potion, potion)
+
+			require.NoError(t, actual.Discard(potion))
+			require.Equal(t, []item.Item{potion}, actual.Inventory)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the player cannot afford it", func(t *testing.T) {
+			actual := player.New("Elmster")
+			actual.Earn(10)
+
+			require.EqualError(t, actual.Pay(11), "not enough gold")
+			require.Equal(t, 10, actual.Gold)
+		})
+
+		t.Run("when the item is not in the inventory", func(t *testing.T) {
+			actual := player.New("Elmster")
+
+			require.EqualError(t, actual.Discard(item.Item{Name: "Potion"}), "item not in inventory")
+		})
+	})
+}
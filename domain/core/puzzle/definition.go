@@ -0,0 +1,54 @@
+package puzzle
+
+import (
+	"errors"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+)
+
+// Definition describes a puzzle in a content file. Prompt is the question or
+// hint shown to the player, and which of Answers, Sequence or Code holds the
+// solution depends on the kind.
+type Definition struct {
+	Name     string      `json:"name"`
+	Kind     Kind        `json:"kind"`
+	Prompt   string      `json:"prompt"`
+	Answers  []string    `json:"answers,omitempty"`
+	Sequence []int       `json:"sequence,omitempty"`
+	Code     string      `json:"code,omitempty"`
+	Attempts int         `json:"attempts"`
+	Penalty  int         `json:"penalty,omitempty"`
+	Reward   []item.Item `json:"reward,omitempty"`
+}
+
+var invalidPuzzleKind = errors.New("invalid puzzle kind")
+
+// Build creates the puzzle the definition describes.
+func (definition Definition) Build() (Puzzle, error) {
+	if definition.Attempts < 1 {
+		return nil, errors.New("puzzle needs at least one attempt")
+	}
+
+	switch definition.Kind {
+	case KindRiddle:
+		if len(definition.Answers) == 0 {
+			return nil, errors.New("riddle needs an answer")
+		}
+
+		return Riddle{Question: definition.Prompt, Answers: definition.Answers}, nil
+	case KindLevers:
+		if len(definition.Sequence) == 0 {
+			return nil, errors.New("levers need a sequence")
+		}
+
+		return Levers{Hint: definition.Prompt, Sequence: definition.Sequence}, nil
+	case KindCombination:
+		if definition.Code == "" {
+			return nil, errors.New("combination needs a code")
+		}
+
+		return Combination{Hint: definition.Prompt, Code: definition.Code}, nil
+	default:
+		return nil, invalidPuzzleKind
+	}
+}
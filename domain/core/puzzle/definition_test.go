@@ -0,0 +1,46 @@
+package puzzle_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionBuild(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when building each kind", func(t *testing.T) {
+			riddle, err := puzzle.Definition{Kind: puzzle.KindRiddle, Prompt: "?", Answers: []string{"echo"}, Attempts: 1}.Build()
+			require.NoError(t, err)
+			require.Equal(t, puzzle.Riddle{Question: "?", Answers: []string{"echo"}}, riddle)
+
+			levers, err := puzzle.Definition{Kind: puzzle.KindLevers, Prompt: "pull", Sequence: []int{2, 1}, Attempts: 1}.Build()
+			require.NoError(t, err)
+			require.Equal(t, puzzle.Levers{Hint: "pull", Sequence: []int{2, 1}}, levers)
+
+			combination, err := puzzle.Definition{Kind: puzzle.KindCombination, Prompt: "dial", Code: "12", Attempts: 1}.Build()
+			require.NoError(t, err)
+			require.Equal(t, puzzle.Combination{Hint: "dial", Code: "12"}, combination)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the kind is invalid", func(t *testing.T) {
+			_, err := puzzle.Definition{Kind: "maze", Attempts: 1}.Build()
+
+			require.EqualError(t, err, "invalid puzzle kind")
+		})
+
+		t.Run("when there are no attempts", func(t *testing.T) {
+			_, err := puzzle.Definition{Kind: puzzle.KindRiddle, Answers: []string{"echo"}}.Build()
+
+			require.EqualError(t, err, "puzzle needs at least one attempt")
+		})
+
+		t.Run("when the solution is missing", func(t *testing.T) {
+			_, err := puzzle.Definition{Kind: puzzle.KindLevers, Attempts: 1}.Build()
+
+			require.EqualError(t, err, "levers need a sequence")
+		})
+	})
+}
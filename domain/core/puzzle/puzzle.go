@@ -0,0 +1,103 @@
+package puzzle
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Kind is the sort of puzzle a room poses.
+type Kind string
+
+const (
+	KindRiddle      Kind = "riddle"
+	KindLevers      Kind = "levers"
+	KindCombination Kind = "combination"
+)
+
+// Puzzle is something the player solves by giving an answer.
+type Puzzle interface {
+	Kind() Kind
+	Prompt() string
+	Solve(answer string) bool
+}
+
+// Riddle is solved by any of its answers, ignoring case and surrounding
+// spaces.
+type Riddle struct {
+	Question string
+	Answers  []string
+}
+
+func (riddle Riddle) Kind() Kind {
+	return KindRiddle
+}
+
+func (riddle Riddle) Prompt() string {
+	return riddle.Question
+}
+
+func (riddle Riddle) Solve(answer string) bool {
+	return slices.ContainsFunc(riddle.Answers, func(candidate string) bool {
+		return strings.EqualFold(strings.TrimSpace(candidate), strings.TrimSpace(answer))
+	})
+}
+
+// Levers is solved by pulling the levers, numbered from one, in the order of
+// the sequence. Answers list the levers separated by spaces or commas.
+type Levers struct {
+	Hint     string
+	Sequence []int
+}
+
+func (levers Levers) Kind() Kind {
+	return KindLevers
+}
+
+func (levers Levers) Prompt() string {
+	return levers.Hint
+}
+
+func (levers Levers) Solve(answer string) bool {
+	fields := strings.FieldsFunc(answer, func(r rune) bool { return r == ' ' || r == ',' })
+	pulled := make([]int, 0, len(fields))
+	for _, field := range fields {
+		lever, err := strconv.Atoi(field)
+		if err != nil {
+			return false
+		}
+
+		pulled = append(pulled, lever)
+	}
+
+	return slices.Equal(levers.Sequence, pulled)
+}
+
+// Combination is solved by dialling its code. Spaces and dashes between the
+// digits are ignored.
+type Combination struct {
+	Hint string
+	Code string
+}
+
+func (combination Combination) Kind() Kind {
+	return KindCombination
+}
+
+func (combination Combination) Prompt() string {
+	return combination.Hint
+}
+
+func (combination Combination) Solve(answer string) bool {
+	dialled := strings.NewReplacer(" ", "", "-", "").Replace(answer)
+
+	return dialled != "" && dialled == combination.Code
+}
+
+// Result is what an attempt at a puzzle came to. Penalty is the damage a
+// wrong answer deals to whoever gave it.
+type Result struct {
+	Solved    bool
+	Remaining int
+	Penalty   int
+}
@@ -0,0 +1,38 @@
+package puzzle_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/puzzle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiddle(t *testing.T) {
+	riddle := puzzle.Riddle{Question: "What has keys but opens no locks?", Answers: []string{"piano", "a piano"}}
+
+	require.Equal(t, puzzle.KindRiddle, riddle.Kind())
+	require.Equal(t, "What has keys but opens no locks?", riddle.Prompt())
+	require.True(t, riddle.Solve(" A Piano "))
+	require.False(t, riddle.Solve("keyboard"))
+}
+
+func TestLevers(t *testing.T) {
+	levers := puzzle.Levers{Hint: "Three levers jut from the wall.", Sequence: []int{3, 1, 2}}
+
+	require.Equal(t, puzzle.KindLevers, levers.Kind())
+	require.True(t, levers.Solve("3 1 2"))
+	require.True(t, levers.Solve("3,1, 2"))
+	require.False(t, levers.Solve("1 2 3"))
+	require.False(t, levers.Solve("3 1"))
+	require.False(t, levers.Solve("three one two"))
+}
+
+func TestCombination(t *testing.T) {
+	combination := puzzle.Combination{Hint: "A dial with ten digits.", Code: "427"}
+
+	require.Equal(t, puzzle.KindCombination, combination.Kind())
+	require.True(t, combination.Solve("4-2-7"))
+	require.True(t, combination.Solve("4 2 7"))
+	require.False(t, combination.Solve("724"))
+	require.False(t, puzzle.Combination{}.Solve(""), "an empty code never opens")
+}
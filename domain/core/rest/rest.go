@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+)
+
+// Healer is whoever rests, such as the player.
+type Healer interface {
+	Heal(amount int) int
+}
+
+// Sanctuary is a safe spot to rest for up to Turns turns, healing Heal life
+// each turn. Every turn has an Ambush percent chance of one of the
+// Wanderers stumbling in and cutting the rest short.
+type Sanctuary struct {
+	Turns     int
+	Heal      int
+	Ambush    int
+	Wanderers []enemy.Kind
+}
+
+// Respite is how a rest went: the turns rested, the life recovered and who
+// interrupted it, if anyone.
+type Respite struct {
+	Turns    int
+	Healed   int
+	Ambusher *enemy.Enemy
+}
+
+// Rest heals the healer turn by turn until the rest is over or a wanderer
+// interrupts it. The ambush is rolled before healing each turn.
+func (sanctuary Sanctuary) Rest(healer Healer, random dice.Random) Respite {
+	respite := Respite{}
+	for respite.Turns < sanctuary.Turns {
+		if len(sanctuary.Wanderers) > 0 && random.Intn(100) < sanctuary.Ambush {
+			respite.Ambusher = enemy.New(sanctuary.Wanderers[random.Intn(len(sanctuary.Wanderers))])
+			return respite
+		}
+
+		respite.Turns++
+		respite.Healed += healer.Heal(sanctuary.Heal)
+	}
+
+	return respite
+}
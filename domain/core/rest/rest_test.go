@@ -0,0 +1,46 @@
+package rest_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/rest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanctuaryRest(t *testing.T) {
+	sanctuary := rest.Sanctuary{Turns: 3, Heal: 10, Ambush: 20, Wanderers: []enemy.Kind{enemy.Goblin, enemy.Orc}}
+
+	t.Run("heals every turn while undisturbed", func(t *testing.T) {
+		healer := player.New("Elmster")
+		healer.Life.Value = 75
+
+		respite := sanctuary.Rest(healer, dicetest.Rolls(99, 99, 99))
+
+		require.Equal(t, rest.Respite{Turns: 3, Healed: 25}, respite, "never heals past the maximum")
+		require.Equal(t, 100, healer.Health())
+	})
+
+	t.Run("is cut short by a wanderer", func(t *testing.T) {
+		healer := player.New("Elmster")
+		healer.Life.Value = 50
+
+		respite := sanctuary.Rest(healer, dicetest.Rolls(20, 19, 1))
+
+		require.Equal(t, 1, respite.Turns)
+		require.Equal(t, 10, respite.Healed)
+		require.Equal(t, enemy.New(enemy.Orc), respite.Ambusher)
+		require.Equal(t, 60, healer.Health())
+	})
+
+	t.Run("is never interrupted without wanderers", func(t *testing.T) {
+		healer := player.New("Elmster")
+
+		respite := rest.Sanctuary{Turns: 2, Heal: 5, Ambush: 100}.Rest(healer, dicetest.Rolls(0, 0))
+
+		require.Equal(t, 2, respite.Turns)
+		require.Nil(t, respite.Ambusher)
+	})
+}
@@ -0,0 +1,29 @@
+package scene
+
+// Lighting is how well a place can be seen.
+type Lighting string
+
+const (
+	Bright Lighting = "bright"
+	Dim    Lighting = "dim"
+	// Dark hides whatever lies around until a torch is lit.
+	Dark Lighting = "dark"
+)
+
+// Scene is what the player sees of a place: its name, a description, the
+// features worth a closer look and how well it is lit.
+type Scene struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Features    []string `json:"features,omitempty"`
+	Lighting    Lighting `json:"lighting,omitempty"`
+}
+
+// OrBright is the lighting of the scene, bright when it has none.
+func (scene Scene) OrBright() Lighting {
+	if scene.Lighting == "" {
+		return Bright
+	}
+
+	return scene.Lighting
+}
@@ -0,0 +1,184 @@
+package shop
+
+import (
+	"errors"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+)
+
+// Listing is an item the merchant sells. Stock is how many are left and
+// Capacity how many the merchant restocks up to.
+type Listing struct {
+	Item     item.Item
+	Stock    int
+	Capacity int
+}
+
+// Modifier raises or, when negative, lowers by Percent the prices of items of
+// its type, or of every item when it has no type.
+type Modifier struct {
+	Type    item.Type
+	Percent int
+}
+
+// Merchant trades items for gold. Prices start from the items' own prices and
+// go through every modifier, and the merchant buys items back at their sell
+// value, modified the same way. Every RestockEvery visits the merchant
+// restocks each listing up to its capacity.
+type Merchant struct {
+	Name         string
+	Listings     []*Listing
+	Modifiers    []Modifier
+	RestockEvery int
+	visits       int
+}
+
+var (
+	unknownListing = errors.New("item not for sale")
+	outOfStock     = errors.New("item out of stock")
+)
+
+// Price is what the merchant charges for the item.
+func (merchant *Merchant) Price(sold item.Item) int {
+	return merchant.modify(sold, sold.Price())
+}
+
+// Offer is what the merchant pays for the item.
+func (merchant *Merchant) Offer(bought item.Item) int {
+	return merchant.modify(bought, bought.SellValue())
+}
+
+// Visit counts a visit from the player, restocking every listing when it is
+// time to.
+func (merchant *Merchant) Visit() {
+	merchant.visits++
+	if merchant.RestockEvery <= 0 || merchant.visits%merchant.RestockEvery != 0 {
+		return
+	}
+
+	for _, listing := range merchant.Listings {
+		listing.Stock = max(listing.Stock, listing.Capacity)
+	}
+}
+
+// Buy sells the player the named item, ignoring case.
+func (merchant *Merchant) Buy(buyer *player.Player, name string) (item.Item, int, error) {
+	listing := merchant.listing(name)
+	if listing == nil {
+		return item.Item{}, 0, unknownListing
+	}
+
+	if listing.Stock <= 0 {
+		return item.Item{}, 0, outOfStock
+	}
+
+	price := merchant.Price(listing.Item)
+	if err := buyer.Pay(price); err != nil {
+		return item.Item{}, 0, err
+	}
+
+	listing.Stock--
+	bought := listing.Item.Clone()
+	buyer.Collect(bought)
+
+	return bought, price, nil
+}
+
+// Sell buys the named item, ignoring case, from the player's inventory. The
+// merchant puts it up for sale without ever restocking it. Keys are refused,
+// as the player needs them to get through the dungeon.
+func (merchant *Merchant) Sell(seller *player.Player, name string) (item.Item, int, error) {
+	var sold *item.Item
+	for _, carried := range seller.Inventory {
+		if carried.Called(name) {
+			sold = &carried
+			break
+		}
+	}
+
+	if sold == nil {
+		return item.Item{}, 0, errors.New("item not in inventory")
+	}
+
+	if sold.Type == item.Key {
+		return item.Item{}, 0, errors.New("merchant does not buy keys")
+	}
+
+	if err := seller.Discard(*sold); err != nil {
+		return item.Item{}, 0, err
+	}
+
+	offer := merchant.Offer(*sold)
+	seller.Earn(offer)
+
+	// Items with affixes are listed apart from the plain ones they were rolled
+	// on.
+	if listing := merchant.listing(sold.Title()); listing != nil && listing.Item.Prototype() == sold.Prototype() && listing.Item.Title() == sold.Title() {
+		listing.Stock++
+	} else {
+		merchant.Listings = append(merchant.Listings, &Listing{Item: *sold, Stock: 1})
+	}
+
+	return *sold, offer, nil
+}
+
+// Repair mends the named item the customer carries, ignoring case, for its
+// repair price.
+func (merchant *Merchant) Repair(customer *player.Player, name string) (item.Item, int, error) {
+	repaired, ok := customer.Carried(name)
+	if !ok {
+		return item.Item{}, 0, errors.New("item not carried")
+	}
+
+	price := repaired.RepairPrice()
+	if price == 0 {
+		_, err := repaired.Repair()
+		return item.Item{}, 0, err
+	}
+
+	price = merchant.modify(repaired, price)
+	if err := customer.Pay(price); err != nil {
+		return item.Item{}, 0, err
+	}
+
+	_, err := repaired.Repair()
+
+	return repaired, price, err
+}
+
+func (merchant *Merchant) listing(name string) *Listing {
+	for _, listing := range merchant.Listings {
+		if listing.Item.Called(name) {
+			return listing
+		}
+	}
+
+	return nil
+}
+
+func (merchant *Merchant) modify(traded item.Item, price int) int {
+	percent := 100
+	for _, modifier := range merchant.Modifiers {
+		if modifier.Type == "" || modifier.Type == traded.Type {
+			percent += modifier.Percent
+		}
+	}
+
+	return max(price*percent/100, 0)
+}
+
+// GeneralStore is a merchant stocking the basics, with a discount on potions,
+// who restocks on every other visit.
+func GeneralStore() *Merchant {
+	return &Merchant{
+		Name: "General store",
+		Listings: []*Listing{
+			{Item: item.Item{Name: "Potion", Type: item.Potion, Rarity: item.Common}, Stock: 5, Capacity: 5},
+			{Item: item.Item{Name: "Longsword", Type: item.Weapon, Rarity: item.Uncommon}, Stock: 1, Capacity: 1},
+			{Item: item.Item{Name: "Leather armour", Type: item.Armour, Rarity: item.Common}, Stock: 2, Capacity: 2},
+		},
+		Modifiers:    []Modifier{{Type: item.Potion, Percent: -20}},
+		RestockEvery: 2,
+	}
+}
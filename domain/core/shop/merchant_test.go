@@ -0,0 +1,217 @@
+package shop_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/shop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerchantPrices(t *testing.T) {
+	merchant := &shop.Merchant{Modifiers: []shop.Modifier{{Percent: 10}, {Type: item.Potion, Percent: -30}}}
+	potion := item.Item{Name: "Potion", Type: item.Potion}
+	sword := item.Item{Name: "Sword", Type: item.Weapon}
+
+	require.Equal(t, 8, merchant.Price(potion))
+	require.Equal(t, 33, merchant.Price(sword))
+	require.Equal(t, 16, merchant.Offer(sword))
+}
+
+func TestMerchantBuy(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the player can afford the item", func(t *testing.T) {
+			merchant := shop.GeneralStore()
+			buyer := player.New("Elmster")
+			buyer.Earn(20)
+
+			bought, price, err := merchant.Buy(buyer, "potion")
+
+			require.NoError(t, err)
+			require.Equal(t, "Potion", bought.Name)
+			require.Equal(t, 8, price)
+			require.Equal(t, 12, buyer.Gold)
+			require.Equal(t, []item.Item{bought}, buyer.Inventory)
+			require.Equal(t, 4, merchant.Listings[0].Stock)
+		})
+
+		t.Run("when buying instances of the same prototype", func(t *testing.T) {
+			lantern := item.Item{ID: "lantern", Name: "Lantern", Type: item.Torch}
+			merchant := &shop.Merchant{Listings: []*shop.Listing{{Item: lantern, Stock: 2}}}
+			buyer := player.New("Elmster")
+			buyer.Earn(20)
+
+			first, _, err := merchant.Buy(buyer, "Lantern")
+			require.NoError(t, err)
+			second, _, err := merchant.Buy(buyer, "Lantern")
+			require.NoError(t, err)
+
+			require.NotEqual(t, first, second)
+			require.Equal(t, lantern, first.Prototype())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the item is not for sale", func(t *testing.T) {
+			_, _, err := shop.GeneralStore().Buy(player.New("Elmster"), "Crown")
+
+			require.EqualError(t, err, "item not for sale")
+		})
+
+		t.Run("when the item is out of stock", func(t *testing.T) {
+			merchant := shop.GeneralStore()
+			merchant.Listings[1].Stock = 0
+
+			_, _, err := merchant.Buy(player.New("Elmster"), "Longsword")
+
+			require.EqualError(t, err, "item out of stock")
+		})
+
+		t.Run("when the player cannot afford the item", func(t *testing.T) {
+			merchant := shop.GeneralStore()
+			buyer := player.New("Elmster")
+
+			_, _, err := merchant.Buy(buyer, "Longsword")
+
+			require.EqualError(t, err, "not enough gold")
+			require.Empty(t, buyer.Inventory)
+			require.Equal(t, 1, merchant.Listings[1].Stock)
+		})
+	})
+}
+
+func TestMerchantSell(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the player carries the item", func(t *testing.T) {
+			merchant := shop.GeneralStore()
+			seller := player.New("Elmster")
+			crown := item.Item{Name: "Crown", Type: item.Armour, Rarity: item.Epic}
+			seller.Collect(crown)
+
+			sold, offer, err := merchant.Sell(seller, "crown")
+
+			require.NoError(t, err)
+			require.Equal(t, crown, sold)
+			require.Equal(t, 100, offer)
+			require.Equal(t, 100, seller.Gold)
+			require.Empty(t, seller.Inventory)
+			require.Equal(t, &shop.Listing{Item: crown, Stock: 1}, merchant.Listings[3])
+		})
+
+		t.Run("when the item is an instance of a listing", func(t *testing.T) {
+			lantern := item.Item{ID: "lantern", Name: "Lantern", Type: item.Torch}
+			merchant := &shop.Merchant{Listings: []*shop.Listing{{Item: lantern, Stock: 1}}}
+			seller := player.New("Elmster")
+			seller.Collect(lantern.Clone())
+
+			_, _, err := merchant.Sell(seller, "lantern")
+
+			require.NoError(t, err)
+			require.Len(t, merchant.Listings, 1)
+			require.Equal(t, 2, merchant.Listings[0].Stock)
+		})
+
+		t.Run("when the item rolled affixes, listing it apart by its title", func(t *testing.T) {
+			lantern := item.Item{ID: "lantern", Name: "Lantern", Type: item.Torch}
+			merchant := &shop.Merchant{Listings: []*shop.Listing{{Item: lantern, Stock: 1}}}
+			seller := player.New("Elmster")
+			gilded := lantern.Clone()
+			gilded.Instance.Affixes = []item.Affix{{Name: "Gilded", Position: item.Prefix, Value: 20}}
+			seller.Collect(gilded)
+
+			_, offer, err := merchant.Sell(seller, "gilded lantern")
+
+			require.NoError(t, err)
+			require.Equal(t, 12, offer)
+			require.Len(t, merchant.Listings, 2)
+			require.Equal(t, 1, merchant.Listings[0].Stock)
+			require.Equal(t, "Gilded Lantern", merchant.Listings[1].Item.Title())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the player does not carry the item", func(t *testing.T) {
+			_, _, err := shop.GeneralStore().Sell(player.New("Elmster"), "Crown")
+
+			require.EqualError(t, err, "item not in inventory")
+		})
+
+		t.Run("when the item is a key", func(t *testing.T) {
+			seller := player.New("Elmster")
+			key := item.Item{Name: "Iron key", Type: item.Key}
+			seller.Collect(key)
+
+			_, _, err := shop.GeneralStore().Sell(seller, "iron key")
+
+			require.EqualError(t, err, "merchant does not buy keys")
+			require.Equal(t, []item.Item{key}, seller.Inventory)
+			require.Zero(t, seller.Gold)
+		})
+	})
+}
+
+func TestMerchantRepair(t *testing.T) {
+	sword := item.Item{ID: "iron-sword", Name: "Iron sword", Type: item.Weapon, Durability: 10}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the player can afford the repair", func(t *testing.T) {
+			merchant := &shop.Merchant{Modifiers: []shop.Modifier{{Type: item.Weapon, Percent: 100}}}
+			customer := player.New("Elmster")
+			customer.Earn(20)
+			worn := sword.Clone()
+			worn.Wear(5)
+			customer.Collect(worn)
+
+			repaired, price, err := merchant.Repair(customer, "iron sword")
+
+			require.NoError(t, err)
+			require.Equal(t, 14, price)
+			require.Equal(t, 6, customer.Gold)
+			current, _ := repaired.Condition()
+			require.Equal(t, 10, current)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the item needs no repair", func(t *testing.T) {
+			customer := player.New("Elmster")
+			customer.Collect(sword.Clone())
+
+			_, _, err := shop.GeneralStore().Repair(customer, "Iron sword")
+
+			require.EqualError(t, err, "item needs no repair")
+		})
+
+		t.Run("when the player cannot afford the repair", func(t *testing.T) {
+			customer := player.New("Elmster")
+			worn := sword.Clone()
+			worn.Wear(10)
+			customer.Collect(worn)
+
+			_, _, err := shop.GeneralStore().Repair(customer, "Iron sword")
+
+			require.EqualError(t, err, "not enough gold")
+			require.True(t, worn.Broken())
+		})
+
+		t.Run("when the player does not carry the item", func(t *testing.T) {
+			_, _, err := shop.GeneralStore().Repair(player.New("Elmster"), "Iron sword")
+
+			require.EqualError(t, err, "item not carried")
+		})
+	})
+}
+
+func TestMerchantVisit(t *testing.T) {
+	t.Run("restocks up to capacity on schedule", func(t *testing.T) {
+		merchant := shop.GeneralStore()
+		merchant.Listings[0].Stock = 1
+
+		merchant.Visit()
+		require.Equal(t, 1, merchant.Listings[0].Stock)
+
+		merchant.Visit()
+		require.Equal(t, 5, merchant.Listings[0].Stock)
+	})
+}
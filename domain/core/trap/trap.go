@@ -0,0 +1,123 @@
+package trap
+
+import (
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice"
+	"github.com/pedrokunz/go-design-patterns/domain/core/effect"
+	"github.com/pedrokunz/go-design-patterns/domain/core/element"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+)
+
+// Trigger is what sets a hazard off.
+type Trigger string
+
+const (
+	// TriggerEntry springs the hazard as soon as someone walks in.
+	TriggerEntry Trigger = "entry"
+	// TriggerLoot springs the hazard when someone picks up the room's items.
+	TriggerLoot Trigger = "loot"
+)
+
+// Hazard is a kind of trap. Its attack and effect go through the same damage
+// pipeline as combat. Detect and Disarm are how hard it is to spot and to
+// disarm, taken off the chance the player's stats give.
+type Hazard struct {
+	Name    string
+	Trigger Trigger
+	Attack  internal.Attack
+	Effect  effect.Kind
+	Detect  int
+	Disarm  int
+}
+
+var (
+	SpikePit = Hazard{
+		Name:    "Spike pit",
+		Trigger: TriggerEntry,
+		Attack:  internal.Attack{Min: 15, Max: 25, Element: element.Physical},
+		Effect:  effect.Bleed,
+		Detect:  40,
+		Disarm:  30,
+	}
+	PoisonDart = Hazard{
+		Name:    "Poison dart",
+		Trigger: TriggerLoot,
+		Attack:  internal.Attack{Min: 5, Max: 10, Element: element.Poison},
+		Effect:  effect.Poison,
+		Detect:  60,
+		Disarm:  20,
+	}
+	CollapsingFloor = Hazard{
+		Name:    "Collapsing floor",
+		Trigger: TriggerEntry,
+		Attack:  internal.Attack{Min: 20, Max: 35, Element: element.Physical},
+		Effect:  effect.Stun,
+		Detect:  30,
+		Disarm:  60,
+	}
+)
+
+// Hazards lists every hazard known to the game.
+func Hazards() []Hazard {
+	return []Hazard{SpikePit, PoisonDart, CollapsingFloor}
+}
+
+// Lookup finds the hazard of the given name, ignoring case.
+func Lookup(name string) (Hazard, bool) {
+	for _, hazard := range Hazards() {
+		if strings.EqualFold(hazard.Name, name) {
+			return hazard, true
+		}
+	}
+
+	return Hazard{}, false
+}
+
+// Odds is the precision a hazard strikes with. Hazards never miss or land
+// critical hits, but they can still be dodged.
+func (hazard Hazard) Odds() internal.Precision {
+	return internal.Precision{Accuracy: 100, CritMultiplier: 1}
+}
+
+// Trap is a hazard set in a room, tracking whether it was spotted, disarmed
+// or already sprung.
+type Trap struct {
+	Hazard
+	Detected bool
+	Disarmed bool
+	Sprung   bool
+}
+
+func New(hazard Hazard) *Trap {
+	return &Trap{Hazard: hazard}
+}
+
+// Armed reports whether the trap can still go off.
+func (trap *Trap) Armed() bool {
+	return !trap.Disarmed && !trap.Sprung
+}
+
+// Search rolls whether keen eyes spot the trap. The chance is the searcher's
+// accuracy less the hazard's detection difficulty.
+func (trap *Trap) Search(odds internal.Precision, random dice.Random) bool {
+	if !trap.Detected && trap.Armed() {
+		trap.Detected = random.Intn(100) < odds.Accuracy-trap.Hazard.Detect
+	}
+
+	return trap.Detected
+}
+
+// Defuse rolls whether nimble fingers disarm a spotted trap. The chance is
+// half the disarmer's accuracy plus its evasion and critical chance, less
+// the hazard's disarm difficulty. A failed attempt leaves the trap armed for
+// whoever fumbled it to spring.
+func (trap *Trap) Defuse(odds internal.Precision, random dice.Random) bool {
+	if !trap.Detected || !trap.Armed() {
+		return false
+	}
+
+	trap.Disarmed = random.Intn(100) < odds.Accuracy/2+odds.Evasion+odds.CritChance-trap.Hazard.Disarm
+
+	return trap.Disarmed
+}
@@ -0,0 +1,68 @@
+package trap_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/dice/dicetest"
+	"github.com/pedrokunz/go-design-patterns/domain/core/internal"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrapSearch(t *testing.T) {
+	odds := internal.Precision{Accuracy: 90, Evasion: 5, CritChance: 5}
+
+	t.Run("spots the trap when the roll is under accuracy less difficulty", func(t *testing.T) {
+		actual := trap.New(trap.SpikePit)
+
+		require.False(t, actual.Search(odds, dicetest.Rolls(50)))
+		require.True(t, actual.Search(odds, dicetest.Rolls(49)))
+		require.True(t, actual.Search(odds, dicetest.Rolls(99)), "stays spotted")
+	})
+
+	t.Run("cannot spot a sprung trap", func(t *testing.T) {
+		actual := trap.New(trap.SpikePit)
+		actual.Sprung = true
+
+		require.False(t, actual.Search(odds, dicetest.Rolls()))
+	})
+}
+
+func TestTrapDefuse(t *testing.T) {
+	t.Run("favours nimble fingers", func(t *testing.T) {
+		rogue := internal.Precision{Accuracy: 95, Evasion: 20, CritChance: 20}
+		warrior := internal.Precision{Accuracy: 90, Evasion: 5, CritChance: 5}
+
+		nimble := trap.New(trap.SpikePit)
+		nimble.Detected = true
+		clumsy := trap.New(trap.SpikePit)
+		clumsy.Detected = true
+
+		require.True(t, nimble.Defuse(rogue, dicetest.Rolls(56)))
+		require.False(t, clumsy.Defuse(warrior, dicetest.Rolls(56)))
+		require.True(t, nimble.Disarmed)
+		require.False(t, nimble.Armed())
+		require.True(t, clumsy.Armed())
+	})
+
+	t.Run("needs the trap to be spotted first", func(t *testing.T) {
+		actual := trap.New(trap.PoisonDart)
+
+		require.False(t, actual.Defuse(internal.Precision{Accuracy: 100, Evasion: 100}, dicetest.Rolls()))
+	})
+}
+
+func TestLookup(t *testing.T) {
+	t.Run("finds a hazard by name", func(t *testing.T) {
+		hazard, ok := trap.Lookup("spike pit")
+
+		require.True(t, ok)
+		require.Equal(t, trap.SpikePit, hazard)
+	})
+
+	t.Run("misses unknown hazards", func(t *testing.T) {
+		_, ok := trap.Lookup("Rolling boulder")
+
+		require.False(t, ok)
+	})
+}
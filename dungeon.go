@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
+	"github.com/pedrokunz/go-design-patterns/domain/core/craft"
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+// dungeon walks the player through the rooms of the game in order.
+type dungeon struct {
+	state      *game.State
+	encounter  *combat.Encounter
+	scanner    *bufio.Scanner
+	random     *rand.Rand
+	checkpoint string
+	book       *craft.Book
+}
+
+// explore visits every room in order, reporting whether the player made it
+// through all of them. A player who dies wakes up at the last checkpoint, if
+// there is one, and carries on from the room after it.
+func (dungeon *dungeon) explore() bool {
+	// A checkpoint left over from an earlier game does not carry over.
+	_ = os.Remove(dungeon.checkpoint)
+
+	for index := 0; index < len(dungeon.state.Rooms); index++ {
+		if !dungeon.open(index) {
+			return false
+		}
+
+		if dungeon.visit(index) {
+			continue
+		}
+
+		restored, err := dungeon.state.Restore(dungeon.checkpoint)
+		if err != nil {
+			return false
+		}
+
+		fmt.Println("⏪ You wake up back at the sanctuary...")
+		index = restored
+	}
+
+	return true
+}
+
+// open opens the door into the room at the index, if it has one, reporting
+// whether the player can go in.
+func (dungeon *dungeon) open(index int) bool {
+	state := dungeon.state
+	if _, ok := state.Doors[index]; !ok {
+		return true
+	}
+
+	if err := state.Open(index); err != nil {
+		fmt.Printf("🔒 The way on is shut: %v\n", err)
+		return false
+	}
+
+	fmt.Println("🗝️ The door creaks open.")
+	state.NotifyEvent(event.Progress{Kind: event.DoorOpened, Player: state.Player.Name, Value: index})
+
+	return true
+}
+
+// visit plays the room at the index, reporting whether the player survived
+// it. Modifiers on the room change the player for as long as they are in it.
+func (dungeon *dungeon) visit(index int) bool {
+	state := dungeon.state
+	decorated := state.Rooms[index]
+	dungeon.survey(index)
+
+	if surroundings, ok := decorated.(player.Surroundings); ok {
+		state.Player.Surroundings = surroundings
+		defer func() { state.Player.Surroundings = nil }()
+	}
+
+	switch chamber := room.Base(decorated).(type) {
+	case room.Lair:
+		fmt.Println("A great roar echoes from the depths... 🐉")
+		return dungeon.battle(decorated, chamber.Boss(), chamber.Boss().Enemy, room.KindBoss)
+	case room.Enigma:
+		return solve(state, dungeon.encounter, decorated, chamber, dungeon.scanner)
+	case room.Snare:
+		return explore(state, dungeon.encounter, decorated, chamber, dungeon.random)
+	case room.Market:
+		trade(state, chamber, dungeon.scanner)
+	case room.Haven:
+		return dungeon.rest(index, decorated, chamber)
+	default:
+		for _, foe := range decorated.Enemies() {
+			if foe.Health() <= 0 {
+				continue
+			}
+
+			fmt.Println("Initiate combat!")
+			if !dungeon.battle(decorated, foe, foe, room.KindEnemy) {
+				return false
+			}
+		}
+
+		pickUp(state, decorated)
+	}
+
+	return true
+}
+
+// survey lets the player look around the room they walk into, light a torch,
+// mend their gear with a repair kit or craft before going on.
+func (dungeon *dungeon) survey(index int) {
+	state := dungeon.state
+	chamber := state.Rooms[index]
+	if name := chamber.Scene().Name; name != "" {
+		fmt.Printf("📍 %s\n", name)
+	}
+
+	dungeon.discover()
+	fmt.Println("[look] [light] [repair <item>] [craft <recipe>] [enter] go on")
+	for dungeon.scanner.Scan() {
+		command, name, _ := strings.Cut(strings.TrimSpace(dungeon.scanner.Text()), " ")
+		switch strings.ToLower(command) {
+		case "look":
+			state.NotifyEvent(state.Look(index))
+		case "light":
+			dungeon.light(chamber)
+		case "repair":
+			dungeon.mend(name)
+		case "craft":
+			dungeon.craft(name)
+		default:
+			return
+		}
+	}
+}
+
+// mend uses up one of the player's repair kits on the named item.
+func (dungeon *dungeon) mend(name string) {
+	Player := dungeon.state.Player
+	repaired, err := Player.Mend(name)
+	if err != nil {
+		fmt.Printf("🚫 %v\n", err)
+		return
+	}
+
+	current, durability := repaired.Condition()
+	fmt.Printf("🔧 You repair your %s [%d/%d]\n", strings.ToLower(repaired.Title()), current, durability)
+	dungeon.state.NotifyEvent(event.Progress{Kind: event.ItemRepaired, Player: Player.Name, Subject: string(repaired.Type), Name: repaired.Name})
+}
+
+// discover learns the recipes whatever the player carries hints at.
+func (dungeon *dungeon) discover() {
+	Player := dungeon.state.Player
+	for _, recipe := range dungeon.book.Discover(Player.Belongings()) {
+		fmt.Printf("📜 You work out how to craft a %s\n", strings.ToLower(recipe.Name))
+		dungeon.state.NotifyEvent(event.Progress{Kind: event.RecipeDiscovered, Player: Player.Name, Subject: string(recipe.Result.Type), Name: recipe.Name})
+	}
+}
+
+// craft crafts the named recipe, equipping what it makes if the player can,
+// or lists the recipes the player knows when no name is given.
+func (dungeon *dungeon) craft(name string) {
+	Player := dungeon.state.Player
+	if name == "" {
+		for _, recipe := range dungeon.book.Known() {
+			ingredients := make([]string, 0, len(recipe.Ingredients))
+			for _, ingredient := range recipe.Ingredients {
+				ingredients = append(ingredients, ingredient.String())
+			}
+			fmt.Printf("  %s: %s\n", recipe.Name, strings.Join(ingredients, ", "))
+		}
+
+		return
+	}
+
+	crafted, err := dungeon.book.Craft(Player, name)
+	if err != nil {
+		fmt.Printf("🚫 %v\n", err)
+		return
+	}
+
+	fmt.Printf("⚒️ You craft a %s (%s)\n", strings.ToLower(crafted.Name), crafted.Rarity.OrCommon())
+	if Player.Equip(crafted) == nil {
+		fmt.Printf("🛡️ Equipped %s\n", crafted.Title())
+	}
+	dungeon.state.NotifyEvent(event.Progress{Kind: event.ItemCrafted, Player: Player.Name, Subject: string(crafted.Type), Name: crafted.Name})
+}
+
+// light burns one of the player's torches to light up a dark room.
+func (dungeon *dungeon) light(chamber room.Room) {
+	Player := dungeon.state.Player
+	if !chamber.Dark() {
+		fmt.Println("💡 There is light enough here.")
+		return
+	}
+
+	index := slices.IndexFunc(Player.Inventory, func(carried item.Item) bool { return carried.Type == item.Torch })
+	if index < 0 {
+		fmt.Println("🌑 You have no torch.")
+		return
+	}
+
+	torch := Player.Inventory[index]
+	reportError(Player.Discard(torch))
+	chamber.Light()
+	fmt.Printf("🔥 You light a %s\n", strings.ToLower(torch.Name))
+	dungeon.state.NotifyEvent(event.Progress{Kind: event.RoomLit, Player: Player.Name, Subject: string(torch.Type), Name: torch.Name})
+}
+
+// battle fights the foe guarding the room, as the actor it fights as, and
+// once it falls drops the loot of both the foe and the room and picks it all
+// up. It reports whether the player survived.
+func (dungeon *dungeon) battle(chamber room.Room, actor combat.Actor, foe *enemy.Enemy, kind room.Kind) bool {
+	state := dungeon.state
+	if !fight(state, dungeon.encounter, promptChooser{scanner: dungeon.scanner}, actor, foe.Speed, combat.StrategyFor(foe.Type, dungeon.random)) {
+		return false
+	}
+
+	if foe.Health() <= 0 {
+		chamber.Drop(dungeon.generate(foe.Type.Loot().Roll(dungeon.random))...)
+		chamber.Drop(dungeon.generate(kind.Loot().Roll(dungeon.random))...)
+		pickUp(state, chamber)
+		loot(state.Player, foe.Type.Loot().Gold+kind.Loot().Gold)
+	}
+
+	return true
+}
+
+// generate rolls affixes onto the dropped items, as many as their rarity
+// allows.
+func (dungeon *dungeon) generate(drops []item.Item) []item.Item {
+	for index := range drops {
+		drops[index] = drops[index].Enchant(dungeon.random)
+	}
+
+	return drops
+}
+
+// rest saves a checkpoint in the sanctuary and lets the player recover there,
+// fighting off whoever interrupts them. Loot is dropped into and picked up
+// from the room as decorated. It reports whether the player survived.
+func (dungeon *dungeon) rest(index int, chamber room.Room, haven room.Haven) bool {
+	state := dungeon.state
+	Player := state.Player
+
+	fmt.Println("🕯️ You find a quiet sanctuary and set up camp.")
+	if err := state.SaveCheckpoint(index, dungeon.checkpoint); err != nil {
+		reportError(err)
+	} else {
+		state.NotifyEvent(event.Progress{Kind: event.CheckpointSaved, Player: Player.Name, Value: index})
+	}
+
+	respite := haven.Sanctuary().Rest(Player, dungeon.random)
+	fmt.Printf("💤 You rest for %d turns and recover %d life ♥️[%d]\n", respite.Turns, respite.Healed, Player.Health())
+	state.NotifyEvent(event.Progress{Kind: event.PlayerRested, Player: Player.Name, Value: respite.Healed})
+
+	if respite.Ambusher != nil {
+		fmt.Printf("⚠️ A %s stumbles into your camp!\n", respite.Ambusher)
+		if !fight(state, dungeon.encounter, promptChooser{scanner: dungeon.scanner}, respite.Ambusher, respite.Ambusher.Speed, combat.StrategyFor(respite.Ambusher.Type, dungeon.random)) {
+			return false
+		}
+
+		if respite.Ambusher.Health() <= 0 {
+			chamber.Drop(dungeon.generate(respite.Ambusher.Type.Loot().Roll(dungeon.random))...)
+			loot(Player, respite.Ambusher.Type.Loot().Gold)
+		}
+	}
+
+	pickUp(state, chamber)
+
+	return true
+}
@@ -0,0 +1,46 @@
+package event
+
+// Combat is emitted by the combat engine for every attack and death so the
+// outcome of an encounter can be audited afterwards.
+type Combat struct {
+	Kind      Kind   `json:"kind"`
+	Encounter string `json:"encounter"`
+	Turn      int    `json:"turn"`
+	Actor     string `json:"actor"`
+	Target    string `json:"target,omitempty"`
+	Killer    string `json:"killer,omitempty"`
+	Ability   string `json:"ability,omitempty"`
+	Effect    string `json:"effect,omitempty"`
+	Outcome   string `json:"outcome,omitempty"`
+	Element   string `json:"element,omitempty"`
+	Phase     string `json:"phase,omitempty"`
+	Item      string `json:"item,omitempty"`
+	Roll      int    `json:"roll"`
+	Mitigated int    `json:"mitigated"`
+	Damage    int    `json:"damage"`
+	Healed    int    `json:"healed,omitempty"`
+	Life      int    `json:"life"`
+}
+
+// The outcomes a Combat event reports an attack or a trap with.
+const (
+	OutcomeHit      = "hit"
+	OutcomeMiss     = "miss"
+	OutcomeDodge    = "dodge"
+	OutcomeCritical = "crit"
+)
+
+func (combat Combat) Type() Kind {
+	return combat.Kind
+}
+
+// Harms reports whether the event dealt damage to its target, from an attack,
+// a trap or a puzzle's penalty.
+func (combat Combat) Harms() bool {
+	switch combat.Kind {
+	case AttackPerformed, TrapTriggered, PenaltyDealt:
+		return true
+	default:
+		return false
+	}
+}
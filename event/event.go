@@ -21,4 +21,40 @@ func (event *event) Type() Kind {
 const (
 	PlayerJoined Kind = "player_joined"
 	PlayerLeft   Kind = "player_left"
+
+	AttackPerformed Kind = "attack_performed"
+	ActorDied       Kind = "actor_died"
+	AbilityUsed     Kind = "ability_used"
+	ActorHealed     Kind = "actor_healed"
+	ActorStunned    Kind = "actor_stunned"
+	ActorFled       Kind = "actor_fled"
+	PhaseChanged    Kind = "phase_changed"
+	TrapTriggered   Kind = "trap_triggered"
+	PenaltyDealt    Kind = "penalty_dealt"
+	EffectApplied   Kind = "effect_applied"
+	EffectTicked    Kind = "effect_ticked"
+	EffectExpired   Kind = "effect_expired"
+	ItemBroken      Kind = "item_broken"
+
+	ItemCollected       Kind = "item_collected"
+	RoomCleared         Kind = "room_cleared"
+	DungeonEntered      Kind = "dungeon_entered"
+	DungeonCleared      Kind = "dungeon_cleared"
+	AchievementUnlocked Kind = "achievement_unlocked"
+	ExperienceGained    Kind = "experience_gained"
+	LevelUp             Kind = "level_up"
+	PuzzleSolved        Kind = "puzzle_solved"
+	PuzzleFailed        Kind = "puzzle_failed"
+	TrapDetected        Kind = "trap_detected"
+	TrapDisarmed        Kind = "trap_disarmed"
+	ItemBought          Kind = "item_bought"
+	ItemSold            Kind = "item_sold"
+	ItemRepaired        Kind = "item_repaired"
+	ItemCrafted         Kind = "item_crafted"
+	RecipeDiscovered    Kind = "recipe_discovered"
+	PlayerRested        Kind = "player_rested"
+	CheckpointSaved     Kind = "checkpoint_saved"
+	DoorOpened          Kind = "door_opened"
+	RoomViewed          Kind = "room_viewed"
+	RoomLit             Kind = "room_lit"
 )
@@ -0,0 +1,204 @@
+package observer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/item"
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+// AchievementRecord is everything the achievement observer remembers about a
+// player between sessions.
+type AchievementRecord struct {
+	Unlocked       []string       `json:"unlocked"`
+	Kills          map[string]int `json:"kills"`
+	ItemTypes      []string       `json:"item_types"`
+	DamageTaken    int            `json:"damage_taken"`
+	FlawlessClears int            `json:"flawless_clears"`
+}
+
+type Achievement struct {
+	ID       string
+	Title    string
+	Unlocked func(record AchievementRecord) bool
+}
+
+var DefaultAchievements = []Achievement{
+	{
+		ID:    "first_blood",
+		Title: "First blood",
+		Unlocked: func(record AchievementRecord) bool {
+			for _, kills := range record.Kills {
+				if kills > 0 {
+					return true
+				}
+			}
+
+			return false
+		},
+	},
+	{
+		ID:    "troll_slayer",
+		Title: "Troll slayer",
+		Unlocked: func(record AchievementRecord) bool {
+			return record.Kills["Troll"] >= 10
+		},
+	},
+	{
+		ID:    "untouchable",
+		Title: "Untouchable",
+		Unlocked: func(record AchievementRecord) bool {
+			return record.FlawlessClears > 0
+		},
+	},
+	{
+		ID:    "collector",
+		Title: "Collector",
+		Unlocked: func(record AchievementRecord) bool {
+			for _, itemType := range item.Types() {
+				if !slices.Contains(record.ItemTypes, string(itemType)) {
+					return false
+				}
+			}
+
+			return true
+		},
+	},
+}
+
+type AchievementObserverConfig struct {
+	Player       string
+	Path         string
+	Notifier     Notifier
+	Achievements []Achievement
+}
+
+type achievementObserver struct {
+	player       string
+	path         string
+	notifier     Notifier
+	achievements []Achievement
+}
+
+func newAchievementObserver(config any) (Observer, error) {
+	achievementObserverConfig, ok := config.(AchievementObserverConfig)
+	if !ok {
+		return nil, invalidConfigType
+	}
+
+	if achievementObserverConfig.Notifier == nil {
+		return nil, errors.New("notifier cannot be nil")
+	}
+
+	achievements := achievementObserverConfig.Achievements
+	if achievements == nil {
+		achievements = DefaultAchievements
+	}
+
+	return &achievementObserver{
+		player:       achievementObserverConfig.Player,
+		path:         achievementObserverConfig.Path,
+		notifier:     achievementObserverConfig.Notifier,
+		achievements: achievements,
+	}, nil
+}
+
+func (a *achievementObserver) On(evt event.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	records, err := LoadAchievements(a.path)
+	if err != nil {
+		return err
+	}
+
+	record := records[a.player]
+	if !a.track(&record, evt) {
+		return nil
+	}
+
+	unlocked := make([]Achievement, 0)
+	for _, achievement := range a.achievements {
+		if !slices.Contains(record.Unlocked, achievement.ID) && achievement.Unlocked(record) {
+			record.Unlocked = append(record.Unlocked, achievement.ID)
+			unlocked = append(unlocked, achievement)
+		}
+	}
+
+	records[a.player] = record
+	if err := saveJSON(a.path, records); err != nil {
+		return err
+	}
+
+	for _, achievement := range unlocked {
+		notifyErr := a.notifier.Notify(event.Progress{
+			Kind:    event.AchievementUnlocked,
+			Player:  a.player,
+			Subject: achievement.ID,
+			Name:    achievement.Title,
+		})
+		if notifyErr != nil {
+			err = errors.Join(err, notifyErr)
+		}
+	}
+
+	return err
+}
+
+// track folds the event into the record, reporting whether it was relevant to
+// this player at all.
+func (a *achievementObserver) track(record *AchievementRecord, evt event.Event) bool {
+	switch evt := evt.(type) {
+	case event.Combat:
+		switch {
+		case evt.Kind == event.ActorDied && evt.Killer == a.player:
+			if record.Kills == nil {
+				record.Kills = make(map[string]int)
+			}
+			record.Kills[evt.Actor]++
+		case evt.Harms() && evt.Target == a.player:
+			record.DamageTaken += evt.Damage
+		case evt.Kind == event.ActorDied && evt.Actor == a.player:
+			// Dying ends the run, so the next one starts unscathed
+			record.DamageTaken = 0
+		default:
+			return false
+		}
+	case event.Progress:
+		if evt.Player != a.player {
+			return false
+		}
+
+		switch evt.Kind {
+		case event.ItemCollected:
+			if !slices.Contains(record.ItemTypes, evt.Subject) {
+				record.ItemTypes = append(record.ItemTypes, evt.Subject)
+			}
+		case event.DungeonCleared:
+			if record.DamageTaken == 0 {
+				record.FlawlessClears++
+			}
+			record.DamageTaken = 0
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+// LoadAchievements reads the achievement records of every player, keyed by
+// player name.
+func LoadAchievements(path string) (map[string]AchievementRecord, error) {
+	records := make(map[string]AchievementRecord)
+	if err := loadJSON(path, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -0,0 +1,151 @@
+package observer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAchievementObserver(t *testing.T) {
+	newAchievementObserver := func(t *testing.T) (observer.Observer, *MockObserver, string) {
+		path := filepath.Join(t.TempDir(), "achievements.json")
+		notifier := observer.NewNotifier()
+		mockObserver := NewMockObserver()
+		require.NoError(t, notifier.Attach(mockObserver), "error attaching observer")
+
+		achievementObserver, newErr := observer.New(
+			observer.AchievementObserver,
+			observer.AchievementObserverConfig{
+				Player:   "Elmster",
+				Path:     path,
+				Notifier: notifier,
+			},
+		)
+		require.NoError(t, newErr, "error building achievement observer")
+
+		return achievementObserver, mockObserver, path
+	}
+
+	kill := func(actor string) event.Event {
+		return event.Combat{Kind: event.ActorDied, Actor: actor, Killer: "Elmster"}
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the first enemy is killed", func(t *testing.T) {
+			achievementObserver, mockObserver, path := newAchievementObserver(t)
+
+			require.NoError(t, achievementObserver.On(kill("Goblin")))
+			require.NoError(t, achievementObserver.On(kill("Goblin")))
+
+			require.Equal(t, []event.Event{
+				event.Progress{
+					Kind:    event.AchievementUnlocked,
+					Player:  "Elmster",
+					Subject: "first_blood",
+					Name:    "First blood",
+				},
+			}, mockObserver.events, "achievements are unlocked only once")
+
+			records, loadErr := observer.LoadAchievements(path)
+			require.NoError(t, loadErr)
+			require.Equal(t, []string{"first_blood"}, records["Elmster"].Unlocked)
+			require.Equal(t, 2, records["Elmster"].Kills["Goblin"])
+		})
+
+		t.Run("when ten trolls are killed across sessions", func(t *testing.T) {
+			achievementObserver, _, path := newAchievementObserver(t)
+			for range 9 {
+				require.NoError(t, achievementObserver.On(kill("Troll")))
+			}
+
+			notifier := observer.NewNotifier()
+			mockObserver := NewMockObserver()
+			require.NoError(t, notifier.Attach(mockObserver))
+			nextSession, newErr := observer.New(
+				observer.AchievementObserver,
+				observer.AchievementObserverConfig{Player: "Elmster", Path: path, Notifier: notifier},
+			)
+			require.NoError(t, newErr)
+
+			require.NoError(t, nextSession.On(kill("Troll")))
+
+			require.Len(t, mockObserver.events, 1)
+			require.Equal(t, "troll_slayer", mockObserver.events[0].(event.Progress).Subject)
+		})
+
+		t.Run("when a dungeon is cleared without taking damage", func(t *testing.T) {
+			achievementObserver, mockObserver, _ := newAchievementObserver(t)
+			cleared := event.Progress{Kind: event.DungeonCleared, Player: "Elmster"}
+
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.AttackPerformed, Target: "Elmster", Damage: 3}))
+			require.NoError(t, achievementObserver.On(cleared))
+			require.Empty(t, mockObserver.events, "damage was taken during the first run")
+
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.AttackPerformed, Target: "Elmster", Damage: 100}))
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Elmster", Killer: "Troll"}))
+			require.Empty(t, mockObserver.events, "dying starts a new run")
+
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.AttackPerformed, Target: "Elmster", Damage: 0}))
+			require.NoError(t, achievementObserver.On(cleared))
+			require.Len(t, mockObserver.events, 1)
+			require.Equal(t, "untouchable", mockObserver.events[0].(event.Progress).Subject)
+		})
+
+		t.Run("when a puzzle's penalty is the only damage taken", func(t *testing.T) {
+			achievementObserver, mockObserver, _ := newAchievementObserver(t)
+
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.PenaltyDealt, Actor: "Sphinx", Target: "Elmster", Damage: 5}))
+			require.NoError(t, achievementObserver.On(event.Progress{Kind: event.DungeonCleared, Player: "Elmster"}))
+
+			require.Empty(t, mockObserver.events, "the penalty counts as damage taken")
+		})
+
+		t.Run("when every item type is collected", func(t *testing.T) {
+			achievementObserver, mockObserver, _ := newAchievementObserver(t)
+
+			for _, itemType := range []string{"Weapon", "Armour", "Key", "Torch", "Kit", "Material", "Weapon"} {
+				require.NoError(t, achievementObserver.On(event.Progress{Kind: event.ItemCollected, Player: "Elmster", Subject: itemType}))
+			}
+			require.Empty(t, mockObserver.events)
+
+			require.NoError(t, achievementObserver.On(event.Progress{Kind: event.ItemCollected, Player: "Elmster", Subject: "Potion"}))
+			require.Len(t, mockObserver.events, 1)
+			require.Equal(t, "collector", mockObserver.events[0].(event.Progress).Subject)
+		})
+
+		t.Run("when events belong to someone else", func(t *testing.T) {
+			achievementObserver, mockObserver, path := newAchievementObserver(t)
+
+			require.NoError(t, achievementObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Goblin", Killer: "Drizzt"}))
+			require.NoError(t, achievementObserver.On(event.Progress{Kind: event.ItemCollected, Player: "Drizzt", Subject: "Potion"}))
+			require.NoError(t, achievementObserver.On(event.Progress{Kind: event.AchievementUnlocked, Player: "Elmster"}))
+			require.NoError(t, achievementObserver.On(event.New(event.PlayerJoined)))
+
+			require.Empty(t, mockObserver.events)
+			require.NoFileExists(t, path)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when observer config is invalid", func(t *testing.T) {
+			_, newErr := observer.New(observer.AchievementObserver, nil)
+
+			require.EqualError(t, newErr, "invalid config type")
+		})
+
+		t.Run("when notifier is missing", func(t *testing.T) {
+			_, newErr := observer.New(observer.AchievementObserver, observer.AchievementObserverConfig{Player: "Elmster"})
+
+			require.EqualError(t, newErr, "notifier cannot be nil")
+		})
+
+		t.Run("when observer event is invalid", func(t *testing.T) {
+			achievementObserver, _, _ := newAchievementObserver(t)
+
+			require.EqualError(t, achievementObserver.On(nil), "event cannot be nil")
+		})
+	})
+}
@@ -0,0 +1,36 @@
+package observer
+
+import (
+	"fmt"
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+type CombatLogObserverConfig struct {
+	Path string
+}
+
+type combatLogObserver struct {
+	store *event.Store
+}
+
+func newCombatLogObserver(config any) (Observer, error) {
+	combatLogObserverConfig, ok := config.(CombatLogObserverConfig)
+	if !ok {
+		return nil, invalidConfigType
+	}
+
+	return &combatLogObserver{store: event.NewStore(combatLogObserverConfig.Path)}, nil
+}
+
+func (c *combatLogObserver) On(evt event.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	combat, ok := evt.(event.Combat)
+	if !ok {
+		return nil
+	}
+
+	return c.store.Append(combat)
+}
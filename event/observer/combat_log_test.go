@@ -0,0 +1,49 @@
+package observer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombatLogObserver(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when combat events are recorded", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "combat.log")
+			combatLogObserver, newErr := observer.New(
+				observer.CombatLogObserver,
+				observer.CombatLogObserverConfig{Path: path},
+			)
+			require.NoError(t, newErr, "error building combat log observer")
+
+			attack := event.Combat{Kind: event.AttackPerformed, Encounter: "cave", Turn: 1, Actor: "Elmster", Target: "Goblin"}
+			require.NoError(t, combatLogObserver.On(attack))
+			require.NoError(t, combatLogObserver.On(event.New(event.PlayerJoined)), "non combat events are ignored")
+
+			actual, queryErr := event.NewStore(path).Query(event.Query{})
+			require.NoError(t, queryErr)
+			require.Equal(t, []event.Combat{attack}, actual)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when observer config is invalid", func(t *testing.T) {
+			_, newErr := observer.New(observer.CombatLogObserver, nil)
+
+			require.EqualError(t, newErr, "invalid config type")
+		})
+
+		t.Run("when observer event is invalid", func(t *testing.T) {
+			combatLogObserver, newErr := observer.New(
+				observer.CombatLogObserver,
+				observer.CombatLogObserverConfig{Path: filepath.Join(t.TempDir(), "combat.log")},
+			)
+			require.NoError(t, newErr, "error building combat log observer")
+
+			require.EqualError(t, combatLogObserver.On(nil), "event cannot be nil")
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package observer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+type ExperienceObserverConfig struct {
+	Player      *player.Player
+	Progression *player.Progression
+	Notifier    Notifier
+}
+
+type experienceObserver struct {
+	player      *player.Player
+	progression player.Progression
+	notifier    Notifier
+}
+
+func newExperienceObserver(config any) (Observer, error) {
+	experienceObserverConfig, ok := config.(ExperienceObserverConfig)
+	if !ok {
+		return nil, invalidConfigType
+	}
+
+	if experienceObserverConfig.Player == nil {
+		return nil, errors.New("player cannot be nil")
+	}
+
+	if experienceObserverConfig.Notifier == nil {
+		return nil, errors.New("notifier cannot be nil")
+	}
+
+	progression := player.DefaultProgression
+	if experienceObserverConfig.Progression != nil {
+		progression = *experienceObserverConfig.Progression
+	}
+
+	return &experienceObserver{
+		player:      experienceObserverConfig.Player,
+		progression: progression,
+		notifier:    experienceObserverConfig.Notifier,
+	}, nil
+}
+
+func (e *experienceObserver) On(evt event.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	combat, ok := evt.(event.Combat)
+	if !ok || combat.Kind != event.ActorDied || combat.Killer != e.player.Name {
+		return nil
+	}
+
+	points := enemy.Kind(combat.Actor).Experience()
+	if points == 0 {
+		return nil
+	}
+
+	levels := e.player.GainExperience(points, e.progression)
+
+	err := e.notifier.Notify(event.Progress{
+		Kind:    event.ExperienceGained,
+		Player:  e.player.Name,
+		Subject: combat.Actor,
+		Value:   points,
+	})
+
+	for level := e.player.Level - levels + 1; level <= e.player.Level; level++ {
+		notifyErr := e.notifier.Notify(event.Progress{
+			Kind:   event.LevelUp,
+			Player: e.player.Name,
+			Value:  level,
+		})
+		if notifyErr != nil {
+			err = errors.Join(err, notifyErr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,106 @@
+package observer_test
+
+import (
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperienceObserver(t *testing.T) {
+	newExperienceObserver := func(t *testing.T, progression *player.Progression) (observer.Observer, *player.Player, *MockObserver) {
+		notifier := observer.NewNotifier()
+		mockObserver := NewMockObserver()
+		require.NoError(t, notifier.Attach(mockObserver), "error attaching observer")
+
+		elmster := player.New("Elmster")
+		experienceObserver, newErr := observer.New(
+			observer.ExperienceObserver,
+			observer.ExperienceObserverConfig{
+				Player:      elmster,
+				Progression: progression,
+				Notifier:    notifier,
+			},
+		)
+		require.NoError(t, newErr, "error building experience observer")
+
+		return experienceObserver, elmster, mockObserver
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when the player kills an enemy", func(t *testing.T) {
+			experienceObserver, elmster, mockObserver := newExperienceObserver(t, nil)
+
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Troll", Killer: "Elmster"}))
+
+			require.Equal(t, 60, elmster.Experience)
+			require.Equal(t, 1, elmster.Level)
+			require.Equal(t, []event.Event{
+				event.Progress{Kind: event.ExperienceGained, Player: "Elmster", Subject: "Troll", Value: 60},
+			}, mockObserver.events)
+		})
+
+		t.Run("when the player levels up", func(t *testing.T) {
+			progression := player.Progression{
+				Curve:  func(level int) int { return 20 * (level - 1) },
+				Growth: player.Growth{Life: 5},
+			}
+			experienceObserver, elmster, mockObserver := newExperienceObserver(t, &progression)
+
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Goblin", Killer: "Elmster"}))
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Orc", Killer: "Elmster"}))
+
+			require.Equal(t, 3, elmster.Level)
+			require.Equal(t, 110, elmster.Life.Value)
+			require.Equal(t, []event.Event{
+				event.Progress{Kind: event.ExperienceGained, Player: "Elmster", Subject: "Goblin", Value: 20},
+				event.Progress{Kind: event.LevelUp, Player: "Elmster", Value: 2},
+				event.Progress{Kind: event.ExperienceGained, Player: "Elmster", Subject: "Orc", Value: 35},
+				event.Progress{Kind: event.LevelUp, Player: "Elmster", Value: 3},
+			}, mockObserver.events)
+
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Troll", Killer: "Elmster"}))
+			require.Equal(t, 6, elmster.Level)
+			require.Len(t, mockObserver.events, 8, "one event per level reached")
+		})
+
+		t.Run("when the death is irrelevant", func(t *testing.T) {
+			experienceObserver, elmster, mockObserver := newExperienceObserver(t, nil)
+
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Goblin", Killer: "Drizzt"}))
+			require.NoError(t, experienceObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Hydra", Killer: "Elmster"}))
+			require.NoError(t, experienceObserver.On(event.New(event.PlayerJoined)))
+
+			require.Zero(t, elmster.Experience)
+			require.Empty(t, mockObserver.events)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when observer config is invalid", func(t *testing.T) {
+			_, newErr := observer.New(observer.ExperienceObserver, nil)
+
+			require.EqualError(t, newErr, "invalid config type")
+		})
+
+		t.Run("when player is missing", func(t *testing.T) {
+			_, newErr := observer.New(observer.ExperienceObserver, observer.ExperienceObserverConfig{Notifier: observer.NewNotifier()})
+
+			require.EqualError(t, newErr, "player cannot be nil")
+		})
+
+		t.Run("when notifier is missing", func(t *testing.T) {
+			_, newErr := observer.New(observer.ExperienceObserver, observer.ExperienceObserverConfig{Player: player.New("Elmster")})
+
+			require.EqualError(t, newErr, "notifier cannot be nil")
+		})
+
+		t.Run("when observer event is invalid", func(t *testing.T) {
+			experienceObserver, _, _ := newExperienceObserver(t, nil)
+
+			require.EqualError(t, experienceObserver.On(nil), "event cannot be nil")
+		})
+	})
+}
@@ -19,6 +19,14 @@ func (factory *Factory) Build(config any) (o Observer, _ error) {
 	switch factory.kind {
 	case PlayerObserver:
 		return newPlayerObserver(config)
+	case CombatLogObserver:
+		return newCombatLogObserver(config)
+	case AchievementObserver:
+		return newAchievementObserver(config)
+	case StatisticsObserver:
+		return newStatisticsObserver(config)
+	case ExperienceObserver:
+		return newExperienceObserver(config)
 	default:
 		return o, errors.New("invalid observer kind")
 	}
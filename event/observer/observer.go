@@ -15,5 +15,9 @@ func New(kind Kind, config any) (Observer, error) {
 type Kind string
 
 const (
-	PlayerObserver Kind = "player"
+	PlayerObserver      Kind = "player"
+	CombatLogObserver   Kind = "combat_log"
+	AchievementObserver Kind = "achievement"
+	StatisticsObserver  Kind = "statistics"
+	ExperienceObserver  Kind = "experience"
 )
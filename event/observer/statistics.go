@@ -0,0 +1,138 @@
+package observer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/event"
+)
+
+// PlayerStatistics aggregates how a player has performed across sessions.
+type PlayerStatistics struct {
+	Kills        map[string]int `json:"kills"`
+	DamageDealt  int            `json:"damage_dealt"`
+	DamageTaken  int            `json:"damage_taken"`
+	RoomsCleared int            `json:"rooms_cleared"`
+	Deaths       int            `json:"deaths"`
+	FastestClear time.Duration  `json:"fastest_clear"`
+	RunStartedAt time.Time      `json:"run_started_at"`
+}
+
+func (statistics PlayerStatistics) TotalKills() (total int) {
+	for _, kills := range statistics.Kills {
+		total += kills
+	}
+
+	return total
+}
+
+type StatisticsObserverConfig struct {
+	Player string
+	Path   string
+	Now    func() time.Time
+}
+
+type statisticsObserver struct {
+	player string
+	path   string
+	now    func() time.Time
+}
+
+func newStatisticsObserver(config any) (Observer, error) {
+	statisticsObserverConfig, ok := config.(StatisticsObserverConfig)
+	if !ok {
+		return nil, invalidConfigType
+	}
+
+	now := statisticsObserverConfig.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &statisticsObserver{
+		player: statisticsObserverConfig.Player,
+		path:   statisticsObserverConfig.Path,
+		now:    now,
+	}, nil
+}
+
+func (s *statisticsObserver) On(evt event.Event) error {
+	if evt == nil {
+		return fmt.Errorf("event cannot be nil")
+	}
+
+	statistics, err := LoadStatistics(s.path)
+	if err != nil {
+		return err
+	}
+
+	playerStatistics := statistics[s.player]
+	if !s.track(&playerStatistics, evt) {
+		return nil
+	}
+
+	statistics[s.player] = playerStatistics
+
+	return saveJSON(s.path, statistics)
+}
+
+// track folds the event into the statistics, reporting whether it was
+// relevant to this player at all.
+func (s *statisticsObserver) track(statistics *PlayerStatistics, evt event.Event) bool {
+	switch evt := evt.(type) {
+	case event.Combat:
+		switch {
+		case evt.Kind == event.AttackPerformed && evt.Actor == s.player:
+			statistics.DamageDealt += evt.Damage
+		case evt.Harms() && evt.Target == s.player:
+			statistics.DamageTaken += evt.Damage
+		case evt.Kind == event.ActorDied && evt.Killer == s.player:
+			if statistics.Kills == nil {
+				statistics.Kills = make(map[string]int)
+			}
+			statistics.Kills[evt.Actor]++
+		case evt.Kind == event.ActorDied && evt.Actor == s.player:
+			statistics.Deaths++
+			statistics.RunStartedAt = time.Time{}
+		default:
+			return false
+		}
+	case event.Progress:
+		if evt.Player != s.player {
+			return false
+		}
+
+		switch evt.Kind {
+		case event.RoomCleared:
+			statistics.RoomsCleared++
+		case event.DungeonEntered:
+			statistics.RunStartedAt = s.now()
+		case event.DungeonCleared:
+			if statistics.RunStartedAt.IsZero() {
+				return false
+			}
+
+			elapsed := s.now().Sub(statistics.RunStartedAt)
+			if statistics.FastestClear == 0 || elapsed < statistics.FastestClear {
+				statistics.FastestClear = elapsed
+			}
+			statistics.RunStartedAt = time.Time{}
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+// LoadStatistics reads the statistics of every player, keyed by player name.
+func LoadStatistics(path string) (map[string]PlayerStatistics, error) {
+	statistics := make(map[string]PlayerStatistics)
+	if err := loadJSON(path, &statistics); err != nil {
+		return nil, err
+	}
+
+	return statistics, nil
+}
@@ -0,0 +1,88 @@
+package observer_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatisticsObserver(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when a session is recorded", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "statistics.json")
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			statisticsObserver, newErr := observer.New(
+				observer.StatisticsObserver,
+				observer.StatisticsObserverConfig{
+					Player: "Elmster",
+					Path:   path,
+					Now:    func() time.Time { return now },
+				},
+			)
+			require.NoError(t, newErr, "error building statistics observer")
+
+			events := []event.Event{
+				event.Progress{Kind: event.DungeonCleared, Player: "Elmster"},
+				event.Progress{Kind: event.DungeonEntered, Player: "Elmster"},
+				event.Combat{Kind: event.AttackPerformed, Actor: "Elmster", Target: "Troll", Damage: 30},
+				event.Combat{Kind: event.AttackPerformed, Actor: "Troll", Target: "Elmster", Damage: 12},
+				event.Combat{Kind: event.TrapTriggered, Actor: "Spike pit", Target: "Elmster", Damage: 5},
+				event.Combat{Kind: event.PenaltyDealt, Actor: "Sphinx", Target: "Elmster", Damage: 3},
+				event.Combat{Kind: event.ActorDied, Actor: "Troll", Killer: "Elmster"},
+				event.Progress{Kind: event.RoomCleared, Player: "Elmster"},
+				event.Progress{Kind: event.ItemCollected, Player: "Elmster"},
+				event.Progress{Kind: event.RoomCleared, Player: "Drizzt"},
+				event.New(event.PlayerJoined),
+			}
+			for _, evt := range events {
+				require.NoError(t, statisticsObserver.On(evt))
+			}
+
+			now = now.Add(3 * time.Minute)
+			require.NoError(t, statisticsObserver.On(event.Progress{Kind: event.DungeonCleared, Player: "Elmster"}))
+
+			require.NoError(t, statisticsObserver.On(event.Progress{Kind: event.DungeonEntered, Player: "Elmster"}))
+			now = now.Add(5 * time.Minute)
+			require.NoError(t, statisticsObserver.On(event.Progress{Kind: event.DungeonCleared, Player: "Elmster"}))
+
+			require.NoError(t, statisticsObserver.On(event.Progress{Kind: event.DungeonEntered, Player: "Elmster"}))
+			require.NoError(t, statisticsObserver.On(event.Combat{Kind: event.ActorDied, Actor: "Elmster", Killer: "Orc"}))
+
+			statistics, loadErr := observer.LoadStatistics(path)
+			require.NoError(t, loadErr)
+			require.Equal(t, map[string]observer.PlayerStatistics{
+				"Elmster": {
+					Kills:        map[string]int{"Troll": 1},
+					DamageDealt:  30,
+					DamageTaken:  20,
+					RoomsCleared: 1,
+					Deaths:       1,
+					FastestClear: 3 * time.Minute,
+				},
+			}, statistics)
+			require.Equal(t, 1, statistics["Elmster"].TotalKills())
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when observer config is invalid", func(t *testing.T) {
+			_, newErr := observer.New(observer.StatisticsObserver, nil)
+
+			require.EqualError(t, newErr, "invalid config type")
+		})
+
+		t.Run("when observer event is invalid", func(t *testing.T) {
+			statisticsObserver, newErr := observer.New(
+				observer.StatisticsObserver,
+				observer.StatisticsObserverConfig{Player: "Elmster", Path: filepath.Join(t.TempDir(), "statistics.json")},
+			)
+			require.NoError(t, newErr, "error building statistics observer")
+
+			require.EqualError(t, statisticsObserver.On(nil), "event cannot be nil")
+		})
+	})
+}
@@ -0,0 +1,17 @@
+package event
+
+// Progress is emitted when a player moves forward in the game outside of
+// combat, such as picking up an item or clearing a dungeon. Subject holds
+// whatever the progress was about: the item type, the achievement id, etc.
+// Value carries any amount involved, such as the level reached.
+type Progress struct {
+	Kind    Kind   `json:"kind"`
+	Player  string `json:"player"`
+	Subject string `json:"subject,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Value   int    `json:"value,omitempty"`
+}
+
+func (progress Progress) Type() Kind {
+	return progress.Kind
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Store is an append-only log of combat events kept on disk as JSON lines.
+type Store struct {
+	path string
+}
+
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Query narrows down the combat log. Zero values match everything; Actor
+// matches events where the actor is either the attacker or the target.
+type Query struct {
+	Encounter string
+	Actor     string
+	FromTurn  int
+	ToTurn    int
+}
+
+func (query Query) Matches(combat Combat) bool {
+	if query.Encounter != "" && query.Encounter != combat.Encounter {
+		return false
+	}
+
+	if query.Actor != "" && query.Actor != combat.Actor && query.Actor != combat.Target {
+		return false
+	}
+
+	if query.FromTurn > 0 && combat.Turn < query.FromTurn {
+		return false
+	}
+
+	if query.ToTurn > 0 && combat.Turn > query.ToTurn {
+		return false
+	}
+
+	return true
+}
+
+func (store *Store) Append(combat Combat) error {
+	if err := os.MkdirAll(filepath.Dir(store.path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(store.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	line, err := json.Marshal(combat)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+
+	return err
+}
+
+func (store *Store) Query(query Query) ([]Combat, error) {
+	file, err := os.Open(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return make([]Combat, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	combats := make([]Combat, 0)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		var combat Combat
+		if err := json.Unmarshal(scanner.Bytes(), &combat); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", store.path, line, err)
+		}
+
+		if query.Matches(combat) {
+			combats = append(combats, combat)
+		}
+	}
+
+	return combats, scanner.Err()
+}
@@ -0,0 +1,76 @@
+package event_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	combats := []event.Combat{
+		{Kind: event.AttackPerformed, Encounter: "cave", Turn: 1, Actor: "Elmster", Target: "Goblin", Roll: 10, Damage: 10, Life: 90},
+		{Kind: event.AttackPerformed, Encounter: "cave", Turn: 2, Actor: "Goblin", Target: "Elmster", Roll: 5, Damage: 5, Life: 95},
+		{Kind: event.ActorDied, Encounter: "cave", Turn: 3, Actor: "Goblin"},
+		{Kind: event.AttackPerformed, Encounter: "crypt", Turn: 1, Actor: "Elmster", Target: "Troll", Roll: 7, Damage: 7, Life: 93},
+	}
+
+	newStore := func(t *testing.T) *event.Store {
+		store := event.NewStore(filepath.Join(t.TempDir(), "logs", "combat.log"))
+		for _, combat := range combats {
+			require.NoError(t, store.Append(combat), "error appending combat event")
+		}
+
+		return store
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		t.Run("when querying everything", func(t *testing.T) {
+			actual, err := newStore(t).Query(event.Query{})
+
+			require.NoError(t, err)
+			require.Equal(t, combats, actual)
+		})
+
+		t.Run("when querying by encounter", func(t *testing.T) {
+			actual, err := newStore(t).Query(event.Query{Encounter: "crypt"})
+
+			require.NoError(t, err)
+			require.Equal(t, combats[3:], actual)
+		})
+
+		t.Run("when querying by actor", func(t *testing.T) {
+			actual, err := newStore(t).Query(event.Query{Actor: "Goblin"})
+
+			require.NoError(t, err)
+			require.Equal(t, combats[:3], actual)
+		})
+
+		t.Run("when querying by turn range", func(t *testing.T) {
+			actual, err := newStore(t).Query(event.Query{Encounter: "cave", FromTurn: 2, ToTurn: 3})
+
+			require.NoError(t, err)
+			require.Equal(t, combats[1:3], actual)
+		})
+
+		t.Run("when the log does not exist yet", func(t *testing.T) {
+			actual, err := event.NewStore(filepath.Join(t.TempDir(), "missing.log")).Query(event.Query{})
+
+			require.NoError(t, err)
+			require.Empty(t, actual)
+		})
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		t.Run("when the log is corrupted", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "combat.log")
+			require.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o644))
+
+			_, err := event.NewStore(path).Query(event.Query{})
+
+			require.ErrorContains(t, err, path+":1:")
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package event
+
+// View is emitted when the player looks around a room. Items is left empty
+// while the room is too dark to see them.
+type View struct {
+	Kind        Kind     `json:"kind"`
+	Player      string   `json:"player"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Features    []string `json:"features,omitempty"`
+	Lighting    string   `json:"lighting,omitempty"`
+	Dark        bool     `json:"dark,omitempty"`
+	Items       []string `json:"items,omitempty"`
+	Enemies     []string `json:"enemies,omitempty"`
+}
+
+func (view View) Type() Kind {
+	return view.Kind
+}
@@ -2,16 +2,48 @@ package main
 
 import (
 	"bufio"
+	"embed"
+	"errors"
 	"fmt"
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/content"
 	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
 	"github.com/pedrokunz/go-design-patterns/domain/aggregate/room"
-	"github.com/pedrokunz/go-design-patterns/domain/core/enemy"
+	"github.com/pedrokunz/go-design-patterns/domain/core/combat"
 	"github.com/pedrokunz/go-design-patterns/domain/core/item"
 	"github.com/pedrokunz/go-design-patterns/domain/core/player"
+	"github.com/pedrokunz/go-design-patterns/domain/core/trap"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"io/fs"
+	"math/rand"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
+//go:embed content
+var defaults embed.FS
+
+// dungeonName is the dungeon from the content packs every adventure takes
+// place in.
+const dungeonName = "Sunken keep"
+
 func main() {
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[1], err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	play(time.Now().UnixNano())
+}
+
+// play runs an adventure whose rolls are drawn from the seed, so the same
+// seed builds the same dungeon and generates the same loot and affixes.
+func play(seed int64) {
 	fmt.Println("Hello player, what is your name?")
 
 	scanner := bufio.NewScanner(os.Stdin)
@@ -26,67 +58,341 @@ func main() {
 		_, _ = fmt.Fprintf(os.Stderr, "Reading standard input: %v\n", "test")
 	}
 
-	state := game.NewState()
+	classNames := make([]string, 0)
+	for _, class := range player.Classes() {
+		classNames = append(classNames, class.Name)
+	}
+	fmt.Printf("Choose your class (%s):\n", strings.Join(classNames, ", "))
+
 	Player := player.New(name)
+	if scanner.Scan() {
+		class, lookupErr := player.LookupClass(scanner.Text())
+		if lookupErr != nil {
+			fmt.Println("You set off as a humble adventurer.")
+		} else {
+			Player = player.NewWithClass(name, class)
+			fmt.Printf("You set off as a %s.\n", class.Name)
+		}
+	}
+
+	state := game.NewState()
 
 	state.Player = Player
 
-	treasuryRoom := room.Factory(
-		room.FactoryInput{
-			Kind: room.KindTreasure,
-			Items: []item.Item{
-				{
-					Name: "Sword",
-					Type: item.Weapon,
-				},
-				{
-					Name: "Shield",
-					Type: item.Armour,
-				},
-			},
-		},
-	)
-
-	enemyRoom := room.Factory(
-		room.FactoryInput{
-			Kind: room.KindEnemy,
-			Enemies: []*enemy.Enemy{
-				enemy.New(enemy.Goblin),
-			},
-		},
-	)
-
-	state.Rooms = []room.Room{
-		treasuryRoom,
-		enemyRoom,
-	}
-
-	fmt.Println("Initiate combat!")
-
-	Enemy := state.Rooms[1].Enemies()[0]
-	for Enemy.Life.Value > 0 {
+	attachObservers(state)
+
+	random := rand.New(rand.NewSource(seed))
+	fmt.Printf("🎲 Seed %d\n", seed)
+
+	library, err := loadLibrary()
+	if err != nil {
+		reportError(err)
+		return
+	}
+
+	if err = library.Build(dungeonName, state, random); err != nil {
+		reportError(err)
+		return
+	}
+
+	book, err := library.Book()
+	if err != nil {
+		reportError(err)
+		return
+	}
+
+	state.NotifyEvent(event.Progress{Kind: event.DungeonEntered, Player: Player.Name})
+
+	encounter := combat.NewEncounter(time.Now().Format(time.RFC3339Nano), state.Notifier, random)
+	dungeon := &dungeon{
+		state:      state,
+		encounter:  encounter,
+		scanner:    scanner,
+		random:     random,
+		checkpoint: filepath.Join(dataDir, "checkpoint.json"),
+		book:       book,
+	}
+
+	if dungeon.explore() {
+		fmt.Println("The doors swing open. 🚪")
+		state.NotifyEvent(event.Progress{Kind: event.DungeonCleared, Player: Player.Name})
+	}
+
+	fmt.Println("Game over!")
+}
+
+// pickUp takes every item left in the room, equipping whatever the player can.
+func pickUp(state *game.State, chamber room.Room) {
+	Player := state.Player
+	if chamber.Dark() {
+		fmt.Println("🌑 Whatever lies here is lost in the dark.")
+		return
+	}
+
+	for _, treasure := range chamber.Take() {
+		Player.Collect(treasure)
+		fmt.Printf("🎁 Found %s (%s)\n", treasure.Title(), treasure.Rarity.OrCommon())
+		if Player.Equip(treasure) == nil {
+			fmt.Printf("🛡️ Equipped %s\n", treasure.Title())
+		}
+		state.NotifyEvent(event.Progress{
+			Kind:    event.ItemCollected,
+			Player:  Player.Name,
+			Subject: string(treasure.Type),
+			Name:    treasure.Name,
+		})
+	}
+}
+
+// loot puts the gold found on a defeated foe in the player's purse.
+func loot(Player *player.Player, gold int) {
+	if gold > 0 {
+		Player.Earn(gold)
+		fmt.Printf("💰 Found %d gold (%d)\n", gold, Player.Gold)
+	}
+}
+
+// trade lets the player buy and sell with the room's merchant until they
+// leave.
+func trade(state *game.State, market room.Market, scanner *bufio.Scanner) {
+	Player := state.Player
+	merchant := market.Merchant()
+	merchant.Visit()
+
+	fmt.Printf("🏪 Welcome to the %s! [buy <item>] [sell <item>] [repair <item>] [enter] leave\n", strings.ToLower(merchant.Name))
+	for _, listing := range merchant.Listings {
+		if listing.Stock > 0 {
+			fmt.Printf("  %s (%s) x%d: %d gold\n", listing.Item.Title(), listing.Item.Rarity.OrCommon(), listing.Stock, merchant.Price(listing.Item))
+		}
+	}
+
+	for scanner.Scan() {
+		command, name, _ := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+
+		var traded item.Item
+		var gold int
+		var verb string
+		var err error
+		progress := event.Progress{Player: Player.Name}
+		switch strings.ToLower(command) {
+		case "buy":
+			traded, gold, err = merchant.Buy(Player, name)
+			progress.Kind, verb = event.ItemBought, "Bought"
+		case "sell":
+			traded, gold, err = merchant.Sell(Player, name)
+			progress.Kind, verb = event.ItemSold, "Sold"
+		case "repair":
+			traded, gold, err = merchant.Repair(Player, name)
+			progress.Kind, verb = event.ItemRepaired, "Repaired"
+		default:
+			return
+		}
+
+		if err != nil {
+			fmt.Printf("🚫 %v\n", err)
+			continue
+		}
+
+		progress.Subject, progress.Name, progress.Value = string(traded.Type), traded.Name, gold
+		state.NotifyEvent(progress)
+		fmt.Printf("💰 %s %s for %d gold, %d left\n", verb, traded.Title(), gold, Player.Gold)
+	}
+}
+
+// solve asks the player for answers until the puzzle is solved or every
+// attempt is spent, reporting whether the player survived the penalties. The
+// room's items are picked up as decorated, so its modifiers still apply.
+func solve(state *game.State, encounter *combat.Encounter, chamber room.Room, enigma room.Enigma, scanner *bufio.Scanner) bool {
+	Player := state.Player
+	fmt.Printf("🧩 %s\n", enigma.Puzzle().Prompt())
+
+	for enigma.Locked() && scanner.Scan() {
+		result, err := enigma.Attempt(scanner.Text())
+		if err != nil {
+			reportError(err)
+			break
+		}
+
+		progress := event.Progress{
+			Kind:    event.PuzzleSolved,
+			Player:  Player.Name,
+			Subject: string(enigma.Puzzle().Kind()),
+			Name:    enigma.Name(),
+			Value:   result.Remaining,
+		}
+
+		if result.Solved {
+			fmt.Println("✨ Solved!")
+			state.NotifyEvent(progress)
+			pickUp(state, chamber)
+			break
+		}
+
+		progress.Kind = event.PuzzleFailed
+		state.NotifyEvent(progress)
+
+		hit, err := encounter.Penalize(enigma.Name(), result.Penalty, Player)
+		reportError(err)
+		fmt.Printf("❌ Wrong! You took %d damage ♥️[%d] (%d attempts left)\n", hit.Damage, Player.Health(), result.Remaining)
+		if Player.Health() <= 0 {
+			fmt.Println("Player died! ☠️")
+			return false
+		}
+	}
+
+	return true
+}
+
+// explore walks the player through a trapped room: every trap that goes off
+// on entry is searched for and, once spotted, disarmed before it springs, and
+// the same goes for the traps guarding the room's items before picking them
+// up, from the room as decorated. It reports whether the player survived.
+func explore(state *game.State, encounter *combat.Encounter, chamber room.Room, snare room.Snare, random *rand.Rand) bool {
+	Player := state.Player
+	for _, trigger := range []trap.Trigger{trap.TriggerEntry, trap.TriggerLoot} {
+		for _, set := range snare.Traps(trigger) {
+			if set.Search(Player.Odds(), random) {
+				fmt.Printf("👀 You spot a %s\n", strings.ToLower(set.Name))
+				state.NotifyEvent(event.Progress{Kind: event.TrapDetected, Player: Player.Name, Name: set.Name})
+
+				if set.Defuse(Player.Odds(), random) {
+					fmt.Printf("🔧 You disarm the %s\n", strings.ToLower(set.Name))
+					state.NotifyEvent(event.Progress{Kind: event.TrapDisarmed, Player: Player.Name, Name: set.Name})
+					continue
+				}
+			}
+
+			_, err := encounter.Spring(set, Player)
+			reportError(err)
+
+			if Player.Health() <= 0 {
+				fmt.Println("Player died! ☠️")
+				return false
+			}
+		}
+
+		if trigger == trap.TriggerLoot {
+			pickUp(state, chamber)
+		}
+	}
+
+	return true
+}
+
+// fight takes turns between the player and the foe, moving at the given
+// speed, until one of them falls or the foe runs away, reporting whether the
+// player is still standing. The player strikes first unless the foe is
+// faster.
+func fight(state *game.State, encounter *combat.Encounter, chooser combat.Chooser, foe combat.Actor, speed int, strategy combat.Chooser) bool {
+	Player := state.Player
+	state.IsPlayerTurn = Player.Initiative() >= speed
+	for foe.Health() > 0 {
 		if state.IsPlayerTurn {
-			damage := Enemy.TakeDamage(Player.Attack)
+			_, turnErr := encounter.TakeTurn(Player, chooser, nil, []combat.Actor{foe})
+			reportError(turnErr)
 			state.IsPlayerTurn = false
 
-			if Enemy.Life.Value <= 0 {
-				fmt.Println("Enemy died! ☠️")
+			if foe.Health() <= 0 {
+				fmt.Printf("%s died! ☠️\n", foe)
+				state.NotifyEvent(event.Progress{Kind: event.RoomCleared, Player: Player.Name})
 				break
-			} else {
-				fmt.Printf("👺 Enemy took %d damage ♥️[%d]\n", damage, Enemy.Life.Value)
 			}
 		} else {
-			damage := Player.TakeDamage(Enemy.Attack)
+			_, turnErr := encounter.TakeTurn(foe, strategy, nil, []combat.Actor{Player})
+			reportError(turnErr)
 			state.IsPlayerTurn = true
 
 			if Player.Life.Value <= 0 {
 				fmt.Println("Player died! ☠️")
+				return false
+			}
+
+			if encounter.Fled(foe) {
 				break
-			} else {
-				fmt.Printf("🤺 Player took %d damage ♥️[%d]\n", damage, Player.Life.Value)
 			}
 		}
 	}
 
-	fmt.Println("Game over!")
+	return true
+}
+
+// loadLibrary layers the content packs embedded in the game under those found
+// in the packs directory, validates them and registers their enemy kinds.
+func loadLibrary() (*content.Library, error) {
+	packs, err := embeddedPacks()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, statErr := os.Stat(packsDir); statErr == nil {
+		custom, loadErr := loadPacks([]string{packsDir})
+		if loadErr != nil {
+			return nil, loadErr
+		}
+
+		packs = append(packs, custom...)
+	}
+
+	library := content.Merge(packs...)
+	if err = library.Validate(); err != nil {
+		return nil, err
+	}
+
+	return library, library.Register()
+}
+
+// embeddedPacks loads the content packs the game ships with.
+func embeddedPacks() ([]content.Pack, error) {
+	embedded, err := fs.Sub(defaults, "content")
+	if err != nil {
+		return nil, err
+	}
+
+	return content.LoadFS(embedded)
+}
+
+// loadPacks loads the pack files, and every pack at the top of the
+// directories, among the paths.
+func loadPacks(paths []string) ([]content.Pack, error) {
+	var files []string
+	var err error
+	for _, path := range paths {
+		entries, readErr := os.ReadDir(path)
+		if readErr != nil {
+			files = append(files, path)
+			continue
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() && content.Supported(entry.Name()) {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	var packs []content.Pack
+	for _, name := range files {
+		file, openErr := os.Open(name)
+		if openErr != nil {
+			err = errors.Join(err, content.Error{File: name, Message: openErr.Error()})
+			continue
+		}
+
+		pack, loadErr := content.Load(name, file)
+		_ = file.Close()
+		if loadErr != nil {
+			err = errors.Join(err, loadErr)
+			continue
+		}
+
+		packs = append(packs, pack)
+	}
+
+	return packs, err
+}
+
+func reportError(err error) {
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
 }
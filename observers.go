@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pedrokunz/go-design-patterns/domain/aggregate/game"
+	"github.com/pedrokunz/go-design-patterns/domain/core/ability"
+	"github.com/pedrokunz/go-design-patterns/domain/core/scene"
+	"github.com/pedrokunz/go-design-patterns/event"
+	"github.com/pedrokunz/go-design-patterns/event/observer"
+)
+
+const dataDir = ".adventure-quest"
+
+var (
+	statisticsPath = filepath.Join(dataDir, "statistics.json")
+	packsDir       = filepath.Join(dataDir, "packs")
+)
+
+// announcer prints the events the player should hear about.
+type announcer struct {
+	player string
+}
+
+func (a announcer) On(evt event.Event) error {
+	if combat, ok := evt.(event.Combat); ok {
+		switch combat.Kind {
+		case event.AttackPerformed:
+			a.attack(combat)
+		case event.AbilityUsed:
+			used, err := ability.Lookup(ability.ID(combat.Ability))
+			if err == nil {
+				fmt.Printf("🌀 %s used %s\n", combat.Actor, used.Name)
+			}
+		case event.ActorHealed:
+			healed := combat.Actor
+			if combat.Target != "" {
+				healed = combat.Target
+			}
+			fmt.Printf("💚 %s healed %d ♥️[%d]\n", healed, combat.Healed, combat.Life)
+		case event.TrapTriggered:
+			if combat.Outcome == event.OutcomeDodge {
+				fmt.Printf("💨 %s dodged the %s\n", combat.Target, strings.ToLower(combat.Actor))
+			} else {
+				fmt.Printf("🪤 %s sprung a %s and took %d damage ♥️[%d]\n", combat.Target, strings.ToLower(combat.Actor), combat.Damage, combat.Life)
+			}
+		case event.PhaseChanged:
+			fmt.Printf("🔥 %s enters its %s phase!\n", combat.Actor, combat.Phase)
+		case event.ActorFled:
+			fmt.Printf("🏃 %s fled!\n", combat.Actor)
+		case event.EffectApplied:
+			fmt.Printf("🧪 %s is affected by %s\n", combat.Target, combat.Effect)
+		case event.EffectTicked:
+			if combat.Healed > 0 {
+				fmt.Printf("💚 %s regenerated %d ♥️[%d]\n", combat.Actor, combat.Healed, combat.Life)
+			} else if combat.Damage > 0 {
+				fmt.Printf("🩸 %s took %d %s damage ♥️[%d]\n", combat.Actor, combat.Damage, combat.Effect, combat.Life)
+			}
+		case event.EffectExpired:
+			fmt.Printf("⌛ %s is no longer affected by %s\n", combat.Actor, combat.Effect)
+		case event.ActorStunned:
+			fmt.Printf("💫 %s is stunned\n", combat.Actor)
+		case event.ItemBroken:
+			fmt.Printf("💥 %s's %s broke!\n", combat.Actor, strings.ToLower(combat.Item))
+		}
+
+		return nil
+	}
+
+	if view, ok := evt.(event.View); ok {
+		a.view(view)
+
+		return nil
+	}
+
+	progress, ok := evt.(event.Progress)
+	if !ok {
+		return nil
+	}
+
+	switch progress.Kind {
+	case event.AchievementUnlocked:
+		fmt.Printf("🏆 Achievement unlocked: %s\n", progress.Name)
+	case event.ExperienceGained:
+		fmt.Printf("✨ Gained %d experience\n", progress.Value)
+	case event.LevelUp:
+		fmt.Printf("⬆️ Reached level %d\n", progress.Value)
+	}
+
+	return nil
+}
+
+func attachObservers(state *game.State) {
+	configs := map[observer.Kind]any{
+		observer.CombatLogObserver: observer.CombatLogObserverConfig{
+			Path: filepath.Join(dataDir, "combat.log"),
+		},
+		observer.AchievementObserver: observer.AchievementObserverConfig{
+			Player:   state.Player.Name,
+			Path:     filepath.Join(dataDir, "achievements.json"),
+			Notifier: state.Notifier,
+		},
+		observer.StatisticsObserver: observer.StatisticsObserverConfig{
+			Player: state.Player.Name,
+			Path:   statisticsPath,
+		},
+		observer.ExperienceObserver: observer.ExperienceObserverConfig{
+			Player:   state.Player,
+			Notifier: state.Notifier,
+		},
+	}
+
+	for _, kind := range []observer.Kind{
+		observer.CombatLogObserver,
+		observer.AchievementObserver,
+		observer.StatisticsObserver,
+		observer.ExperienceObserver,
+	} {
+		o, err := observer.New(kind, configs[kind])
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Creating %s observer: %v\n", kind, err)
+			continue
+		}
+
+		state.AddObserver(o)
+	}
+
+	state.AddObserver(announcer{player: state.Player.Name})
+}
+
+func (a announcer) attack(combat event.Combat) {
+	icon := "👺"
+	if combat.Target == a.player {
+		icon = "🤺"
+	}
+
+	kind := "damage"
+	if combat.Element != "" && combat.Element != "physical" {
+		kind = combat.Element + " damage"
+	}
+
+	switch combat.Outcome {
+	case event.OutcomeMiss:
+		fmt.Printf("💨 %s missed %s\n", combat.Actor, combat.Target)
+	case event.OutcomeDodge:
+		fmt.Printf("💨 %s dodged %s\n", combat.Target, combat.Actor)
+	case event.OutcomeCritical:
+		fmt.Printf("%s %s took %d critical %s! ♥️[%d]\n", icon, combat.Target, combat.Damage, kind, combat.Life)
+	default:
+		fmt.Printf("%s %s took %d %s ♥️[%d]\n", icon, combat.Target, combat.Damage, kind, combat.Life)
+	}
+}
+
+func (a announcer) view(view event.View) {
+	lighting := view.Lighting
+	if lighting == string(scene.Dark) && !view.Dark {
+		lighting = "torchlit"
+	}
+
+	fmt.Printf("🏛️ %s (%s)\n", view.Name, lighting)
+	if view.Description != "" {
+		fmt.Println(view.Description)
+	}
+
+	for _, feature := range view.Features {
+		fmt.Printf("👁️ You notice %s\n", feature)
+	}
+
+	if len(view.Enemies) > 0 {
+		fmt.Printf("👺 Lurking here: %s\n", strings.Join(view.Enemies, ", "))
+	}
+
+	switch {
+	case view.Dark:
+		fmt.Println("🌑 It is too dark to make out anything else.")
+	case len(view.Items) > 0:
+		fmt.Printf("🎁 Lying around: %s\n", strings.Join(view.Items, ", "))
+	}
+}